@@ -0,0 +1,190 @@
+package cache
+
+import "go-data-structure/list/linkedlist"
+
+type arcEntry[K comparable, V any] struct {
+	key   K
+	value V
+	// list holding the entry
+	in *linkedlist.LinkedList[*arcEntry[K, V]]
+}
+
+// ARC is an adaptive replacement cache. It splits entries between t1,
+// seen once recently, and t2, seen at least twice, and remembers the keys
+// recently evicted from them in ghost lists b1 and b2. Hits on ghosts
+// shift the target size 'p' of t1, adapting the cache between recency
+// and frequency, so a single scan cannot flush frequently used entries.
+type ARC[K comparable, V any] struct {
+	config[K, V]
+	capacity int
+	p        int
+	// most recently used first
+	t1, t2, b1, b2 *linkedlist.LinkedList[*arcEntry[K, V]]
+	items          map[K]*linkedlist.Node[*arcEntry[K, V]]
+	stats          Stats
+}
+
+// NewARC returns a cache holding up to 'capacity' entries and remembering
+// as many evicted keys, it panics on WithCost and WithTTL.
+func NewARC[K comparable, V any](capacity int, opts ...Option[K, V]) *ARC[K, V] {
+	if capacity < 1 {
+		panic("cache: invalid capacity, should be at least 1")
+	}
+	return &ARC[K, V]{
+		config:   newCountConfig("ARC", opts),
+		capacity: capacity,
+		t1:       linkedlist.New[*arcEntry[K, V]](),
+		t2:       linkedlist.New[*arcEntry[K, V]](),
+		b1:       linkedlist.New[*arcEntry[K, V]](),
+		b2:       linkedlist.New[*arcEntry[K, V]](),
+		items:    make(map[K]*linkedlist.Node[*arcEntry[K, V]]),
+	}
+}
+
+func (c *ARC[K, V]) Len() int {
+	return c.t1.Len() + c.t2.Len()
+}
+
+func (c *ARC[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// cached returns the node of key if its value is cached, not a ghost.
+func (c *ARC[K, V]) cached(key K) (*linkedlist.Node[*arcEntry[K, V]], bool) {
+	n, ok := c.items[key]
+	if !ok || n.Value.in == c.b1 || n.Value.in == c.b2 {
+		return nil, false
+	}
+	return n, true
+}
+
+// Get returns the value of key and promotes it to the frequent list.
+func (c *ARC[K, V]) Get(key K) (value V, ok bool) {
+	n, ok := c.cached(key)
+	if !ok {
+		c.stats.Misses++
+		return
+	}
+	c.stats.Hits++
+	c.move(n, c.t2)
+	return n.Value.value, true
+}
+
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.cached(key)
+	if !ok {
+		var value V
+		return value, false
+	}
+	return n.Value.value, true
+}
+
+func (c *ARC[K, V]) Contains(key K) bool {
+	_, ok := c.cached(key)
+	return ok
+}
+
+func (c *ARC[K, V]) Put(key K, value V) {
+	n, ok := c.items[key]
+	switch {
+	case ok && (n.Value.in == c.t1 || n.Value.in == c.t2):
+		n.Value.value = value
+		c.move(n, c.t2)
+		return
+	case ok && n.Value.in == c.b1:
+		// recency would have hit, grow t1
+		c.p = min(c.capacity, c.p+max(c.b2.Len()/c.b1.Len(), 1))
+		c.replace(false)
+		n.Value.value = value
+		c.move(n, c.t2)
+		return
+	case ok && n.Value.in == c.b2:
+		// frequency would have hit, shrink t1
+		c.p = max(0, c.p-max(c.b1.Len()/c.b2.Len(), 1))
+		c.replace(true)
+		n.Value.value = value
+		c.move(n, c.t2)
+		return
+	}
+
+	if c.t1.Len()+c.b1.Len() == c.capacity {
+		if c.t1.Len() < c.capacity {
+			c.forget(c.b1)
+			c.replace(false)
+		} else {
+			c.evict(c.t1.Back(), nil)
+		}
+	} else if c.t1.Len()+c.t2.Len()+c.b1.Len()+c.b2.Len() >= c.capacity {
+		if c.t1.Len()+c.t2.Len()+c.b1.Len()+c.b2.Len() == 2*c.capacity {
+			c.forget(c.b2)
+		}
+		c.replace(false)
+	}
+	c.items[key] = c.t1.PushFront(&arcEntry[K, V]{key: key, value: value, in: c.t1})
+}
+
+func (c *ARC[K, V]) Remove(key K) bool {
+	n, ok := c.cached(key)
+	if ok {
+		n.Value.in.Remove(n)
+		delete(c.items, key)
+	}
+	return ok
+}
+
+func (c *ARC[K, V]) Clear() {
+	for _, l := range []*linkedlist.LinkedList[*arcEntry[K, V]]{c.t1, c.t2, c.b1, c.b2} {
+		l.Clear()
+	}
+	clear(c.items)
+	c.p = 0
+}
+
+// replace makes room in a full cache by evicting the least recently used
+// entry of t1 or t2 into its ghost list, depending on the target 'p'.
+func (c *ARC[K, V]) replace(inB2 bool) {
+	if c.t1.Len()+c.t2.Len() < c.capacity {
+		return
+	}
+	if c.t1.Len() > 0 && (c.t1.Len() > c.p || (inB2 && c.t1.Len() == c.p)) {
+		c.evict(c.t1.Back(), c.b1)
+	} else {
+		c.evict(c.t2.Back(), c.b2)
+	}
+}
+
+// evict drops the value of the entry, remembering its key in 'ghost' if any.
+func (c *ARC[K, V]) evict(n *linkedlist.Node[*arcEntry[K, V]], ghost *linkedlist.LinkedList[*arcEntry[K, V]]) {
+	e := n.Value
+	value := e.value
+	var zero V
+	e.value = zero
+	if ghost != nil {
+		c.move(n, ghost)
+	} else {
+		e.in.Remove(n)
+		delete(c.items, e.key)
+	}
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, value)
+	}
+}
+
+// forget drops the least recently evicted key of the ghost list.
+func (c *ARC[K, V]) forget(ghost *linkedlist.LinkedList[*arcEntry[K, V]]) {
+	if n := ghost.Back(); n != nil {
+		ghost.Remove(n)
+		delete(c.items, n.Value.key)
+	}
+}
+
+// move relinks the node to the front of 'to'.
+func (c *ARC[K, V]) move(n *linkedlist.Node[*arcEntry[K, V]], to *linkedlist.LinkedList[*arcEntry[K, V]]) {
+	if n.Value.in == to {
+		to.MoveToFront(n)
+		return
+	}
+	to.SpliceRange(n.Value.in, n, n, to.Front())
+	n.Value.in = to
+}
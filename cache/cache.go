@@ -0,0 +1,106 @@
+// Package cache implements fixed capacity caches with different eviction
+// policies.
+package cache
+
+import "time"
+
+// Cache is implemented by every eviction policy of the package.
+type Cache[K comparable, V any] interface {
+	// Get returns the value of key, counting a hit or a miss and
+	// updating the policy.
+	Get(key K) (V, bool)
+	// Peek returns the value of key without side effects.
+	Peek(key K) (V, bool)
+	// Contains reports whether key is cached without side effects.
+	Contains(key K) bool
+	// Put caches the value, evicting entries if the cache is full.
+	Put(key K, value V)
+	// Remove deletes key, reports false if key is not cached.
+	Remove(key K) bool
+	// Len returns the number of cached entries.
+	Len() int
+	// Clear removes all entries.
+	Clear()
+	// Stats returns the counters since the cache was created.
+	Stats() Stats
+}
+
+var (
+	_ Cache[int, int] = (*LRU[int, int])(nil)
+	_ Cache[int, int] = (*LFU[int, int])(nil)
+	_ Cache[int, int] = (*ARC[int, int])(nil)
+	_ Cache[int, int] = (*TwoQueue[int, int])(nil)
+)
+
+// Stats counts the outcome of cache lookups and evictions.
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns hits divided by lookups, 0 without lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type config[K comparable, V any] struct {
+	cost     func(key K, value V) int
+	weighted bool
+	onEvict  func(key K, value V)
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+func newConfig[K comparable, V any](opts []Option[K, V]) config[K, V] {
+	c := config[K, V]{
+		cost: func(K, V) int { return 1 },
+		now:  time.Now,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// newCountConfig is newConfig for the policies counting entries without
+// expiry, it panics if the options set a cost or a time to live.
+func newCountConfig[K comparable, V any](policy string, opts []Option[K, V]) config[K, V] {
+	c := newConfig(opts)
+	if c.weighted {
+		panic("cache: " + policy + " does not support WithCost")
+	}
+	if c.ttl != 0 {
+		panic("cache: " + policy + " does not support WithTTL")
+	}
+	return c
+}
+
+// Option configures a cache.
+type Option[K comparable, V any] func(*config[K, V])
+
+// WithCost measures capacity by the sum of costs of entries instead of
+// their count, costs must not be negative.
+func WithCost[K comparable, V any](cost func(key K, value V) int) Option[K, V] {
+	return func(c *config[K, V]) { c.cost, c.weighted = cost, true }
+}
+
+// WithOnEvict sets a callback invoked with every entry leaving the cache
+// to make room or on expiry, but not on Remove, Clear or replacement.
+func WithOnEvict[K comparable, V any](fn func(key K, value V)) Option[K, V] {
+	return func(c *config[K, V]) { c.onEvict = fn }
+}
+
+// WithTTL sets the default time to live of entries, zero never expires.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) { c.ttl = ttl }
+}
+
+// WithClock replaces time.Now as the source of time for expiry.
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(c *config[K, V]) { c.now = now }
+}
@@ -0,0 +1,234 @@
+package cache
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleLRU() {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+	fmt.Println(c.Keys())
+	fmt.Println(c.Get("b"))
+	fmt.Printf("%+v\n", c.Stats())
+	// Output:
+	// [c a]
+	// 0 false
+	// {Hits:1 Misses:1 Evictions:1 Expirations:0}
+}
+
+func ExampleLRU_Peek() {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	fmt.Println(c.Peek("a"))
+	c.Put("c", 3)
+	fmt.Println(c.Keys())
+	// Output:
+	// 1 true
+	// [c b]
+}
+
+func ExampleWithCost() {
+	c := NewLRU(10,
+		WithCost(func(key string, value []byte) int { return len(value) }),
+		WithOnEvict(func(key string, value []byte) { fmt.Println("evict", key) }),
+	)
+	c.Put("a", make([]byte, 4))
+	c.Put("b", make([]byte, 4))
+	c.Put("c", make([]byte, 4))
+	c.Put("d", make([]byte, 20))
+	fmt.Println(c.Keys(), c.Used())
+	// Output:
+	// evict a
+	// evict d
+	// [c b] 8
+}
+
+func TestLRUTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	var evicted []string
+	c := NewLRU(3,
+		WithTTL[string, int](time.Minute),
+		WithClock[string, int](func() time.Time { return now }),
+		WithOnEvict(func(key string, value int) { evicted = append(evicted, key) }),
+	)
+	c.Put("a", 1)
+	c.PutTTL("b", 2, 2*time.Minute)
+	c.PutTTL("c", 3, 0)
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(a) after its ttl reports present")
+	}
+	if !c.Contains("b") || !c.Contains("c") || c.Len() != 2 {
+		t.Fatalf("entries b and c should be alive, Len() = %d", c.Len())
+	}
+	now = now.Add(time.Hour)
+	if _, ok := c.Peek("b"); ok {
+		t.Fatalf("Peek(b) after its ttl reports present")
+	}
+	if n := c.RemoveExpired(); n != 1 || c.Len() != 1 {
+		t.Fatalf("RemoveExpired() = %d, Len() = %d, want 1, 1", n, c.Len())
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Fatalf("Get(c) = %d, %v, want 3, true", v, ok)
+	}
+	stats := c.Stats()
+	if stats.Expirations != 2 || stats.Evictions != 0 || stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("Stats() = %+v", stats)
+	}
+	if fmt.Sprint(evicted) != "[a b]" {
+		t.Fatalf("evicted %v, want [a b]", evicted)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[int, int](100)
+	for i := 0; i < 1000; i++ {
+		c.Put(i, i)
+		c.Get(i / 2)
+		if c.Len() > 100 || c.Used() != c.Len() {
+			t.Fatalf("Len() = %d, Used() = %d", c.Len(), c.Used())
+		}
+	}
+	if !c.Remove(999) || c.Remove(999) {
+		t.Fatalf("Remove(999) should succeed once")
+	}
+	c.Clear()
+	if c.Len() != 0 || c.Used() != 0 || c.Contains(998) {
+		t.Fatalf("Clear() leaves entries")
+	}
+}
+
+func ExampleLFU() {
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3)
+	fmt.Println(c.Contains("a"), c.Contains("b"), c.Contains("c"))
+	// Output:
+	// true false true
+}
+
+func TestUnsupportedOptions(t *testing.T) {
+	cost := WithCost(func(key, value int) int { return value })
+	ttl := WithTTL[int, int](time.Minute)
+	for _, p := range []struct {
+		name     string
+		newCache func(...Option[int, int]) Cache[int, int]
+	}{
+		{"LFU", func(opts ...Option[int, int]) Cache[int, int] { return NewLFU(8, opts...) }},
+		{"ARC", func(opts ...Option[int, int]) Cache[int, int] { return NewARC(8, opts...) }},
+		{"2Q", func(opts ...Option[int, int]) Cache[int, int] { return NewTwoQueue(8, opts...) }},
+	} {
+		for _, opt := range []Option[int, int]{cost, ttl} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s accepts an option it ignores", p.name)
+					}
+				}()
+				p.newCache(opt)
+			}()
+		}
+		p.newCache(WithTTL[int, int](0), WithOnEvict(func(key, value int) {}))
+	}
+}
+
+func ExampleReplay() {
+	trace, _ := ReadTrace(strings.NewReader("a\nb\na\nc\na\nb\n"))
+	stats := Replay[string, int](NewLRU[string, int](2), trace, func(string) int { return 0 })
+	fmt.Printf("%+v\n", stats)
+	// Output:
+	// {Hits:2 Misses:4 Evictions:2 Expirations:0}
+}
+
+// testdata/scan.trace requests a hot set of 40 keys over and over,
+// interleaved with scans of keys requested only once.
+func TestScanResistance(t *testing.T) {
+	f, err := os.Open("testdata/scan.trace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	trace, err := ReadTrace(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := func(key string) int { return len(key) }
+	ratios := map[string]float64{}
+	for _, p := range []struct {
+		name string
+		c    Cache[string, int]
+	}{
+		{"LRU", NewLRU[string, int](50)},
+		{"LFU", NewLFU[string, int](50)},
+		{"ARC", NewARC[string, int](50)},
+		{"2Q", NewTwoQueue[string, int](50)},
+	} {
+		stats := Replay(p.c, trace, load)
+		ratios[p.name] = stats.HitRatio()
+		t.Logf("%s: %+v hit ratio %.3f", p.name, stats, stats.HitRatio())
+		if p.c.Len() > 50 {
+			t.Errorf("%s: Len() = %d over capacity", p.name, p.c.Len())
+		}
+	}
+	for _, name := range []string{"LFU", "ARC", "2Q"} {
+		if ratios[name] <= ratios["LRU"] {
+			t.Errorf("%s hit ratio %.3f not better than LRU %.3f on a scan-heavy trace", name, ratios[name], ratios["LRU"])
+		}
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	for _, p := range []struct {
+		name string
+		c    Cache[int, int]
+	}{
+		{"LRU", NewLRU[int, int](64)},
+		{"LFU", NewLFU[int, int](64)},
+		{"ARC", NewARC[int, int](64)},
+		{"2Q", NewTwoQueue[int, int](64)},
+	} {
+		// every policy sees the same sequence of operations
+		r := rand.New(rand.NewSource(1))
+		name, c := p.name, p.c
+		for i := 0; i < 20000; i++ {
+			key := int(r.ExpFloat64() * 50)
+			switch r.Intn(5) {
+			case 0, 1:
+				c.Put(key, -key)
+			case 2, 3:
+				if v, ok := c.Get(key); ok && v != -key {
+					t.Fatalf("%s: Get(%d) = %d", name, key, v)
+				}
+			case 4:
+				present := c.Contains(key)
+				if c.Remove(key) != present || c.Contains(key) {
+					t.Fatalf("%s: Remove(%d) disagrees with Contains", name, key)
+				}
+			}
+			if c.Len() > 64 {
+				t.Fatalf("%s: Len() = %d over capacity", name, c.Len())
+			}
+		}
+		c.Put(1, 1)
+		if v, ok := c.Peek(1); !ok || v != 1 {
+			t.Fatalf("%s: Peek(1) after Put = %d, %v", name, v, ok)
+		}
+		c.Clear()
+		if c.Len() != 0 || c.Contains(1) {
+			t.Fatalf("%s: Clear() leaves entries", name)
+		}
+	}
+}
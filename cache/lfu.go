@@ -0,0 +1,144 @@
+package cache
+
+import "go-data-structure/list/linkedlist"
+
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *linkedlist.Node[*lfuBucket[K, V]]
+}
+
+// lfuBucket holds the entries used 'freq' times, most recently used first.
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries *linkedlist.LinkedList[*lfuEntry[K, V]]
+}
+
+// LFU is a cache evicting the least frequently used entry, the least
+// recently used one among equally used entries. Entries live in buckets
+// of equal use count kept in a linked list of ascending count, so every
+// operation is O(1).
+type LFU[K comparable, V any] struct {
+	config[K, V]
+	capacity int
+	items    map[K]*linkedlist.Node[*lfuEntry[K, V]]
+	buckets  *linkedlist.LinkedList[*lfuBucket[K, V]]
+	stats    Stats
+}
+
+// NewLFU returns a cache holding up to 'capacity' entries, it panics on
+// WithCost and WithTTL.
+func NewLFU[K comparable, V any](capacity int, opts ...Option[K, V]) *LFU[K, V] {
+	if capacity < 1 {
+		panic("cache: invalid capacity, should be at least 1")
+	}
+	return &LFU[K, V]{
+		config:   newCountConfig("LFU", opts),
+		capacity: capacity,
+		items:    make(map[K]*linkedlist.Node[*lfuEntry[K, V]]),
+		buckets:  linkedlist.New[*lfuBucket[K, V]](),
+	}
+}
+
+func (c *LFU[K, V]) Len() int {
+	return len(c.items)
+}
+
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Get returns the value of key and increases its use count.
+func (c *LFU[K, V]) Get(key K) (value V, ok bool) {
+	n, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return
+	}
+	c.stats.Hits++
+	c.touch(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value of key without increasing its use count.
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.items[key]
+	if !ok {
+		var value V
+		return value, false
+	}
+	return n.Value.value, true
+}
+
+func (c *LFU[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put caches the value, replacing a value counts as a use.
+func (c *LFU[K, V]) Put(key K, value V) {
+	if n, ok := c.items[key]; ok {
+		n.Value.value = value
+		c.touch(n)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+	front := c.buckets.Front()
+	if front == nil || front.Value.freq != 1 {
+		front = c.buckets.PushFront(&lfuBucket[K, V]{freq: 1, entries: linkedlist.New[*lfuEntry[K, V]]()})
+	}
+	e := &lfuEntry[K, V]{key: key, value: value, bucket: front}
+	c.items[key] = front.Value.entries.PushFront(e)
+}
+
+func (c *LFU[K, V]) Remove(key K) bool {
+	n, ok := c.items[key]
+	if ok {
+		c.remove(n)
+	}
+	return ok
+}
+
+func (c *LFU[K, V]) Clear() {
+	clear(c.items)
+	c.buckets.Clear()
+}
+
+// touch moves the entry to the bucket of the next use count.
+func (c *LFU[K, V]) touch(n *linkedlist.Node[*lfuEntry[K, V]]) {
+	bucket := n.Value.bucket
+	next := bucket.Next()
+	if next == nil || next.Value.freq != bucket.Value.freq+1 {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{
+			freq:    bucket.Value.freq + 1,
+			entries: linkedlist.New[*lfuEntry[K, V]](),
+		}, bucket)
+	}
+	// relink the node itself, no allocation
+	entries := next.Value.entries
+	entries.SpliceRange(bucket.Value.entries, n, n, entries.Front())
+	n.Value.bucket = next
+	if bucket.Value.entries.Len() == 0 {
+		c.buckets.Remove(bucket)
+	}
+}
+
+func (c *LFU[K, V]) remove(n *linkedlist.Node[*lfuEntry[K, V]]) {
+	bucket := n.Value.bucket
+	bucket.Value.entries.Remove(n)
+	if bucket.Value.entries.Len() == 0 {
+		c.buckets.Remove(bucket)
+	}
+	delete(c.items, n.Value.key)
+}
+
+func (c *LFU[K, V]) evict() {
+	n := c.buckets.Front().Value.entries.Back()
+	c.remove(n)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(n.Value.key, n.Value.value)
+	}
+}
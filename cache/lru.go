@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"time"
+
+	"go-data-structure/list/linkedlist"
+)
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	cost    int
+	expires time.Time
+}
+
+// LRU is a cache evicting the least recently used entries once the
+// capacity is exceeded, recency is kept by moving accessed nodes of a
+// linked list to the front.
+type LRU[K comparable, V any] struct {
+	config[K, V]
+	capacity int
+	used     int
+	items    map[K]*linkedlist.Node[entry[K, V]]
+	ll       *linkedlist.LinkedList[entry[K, V]]
+	stats    Stats
+}
+
+// NewLRU returns a cache holding entries up to 'capacity', counted by
+// entries or by the cost function of WithCost.
+func NewLRU[K comparable, V any](capacity int, opts ...Option[K, V]) *LRU[K, V] {
+	if capacity < 1 {
+		panic("cache: invalid capacity, should be at least 1")
+	}
+	return &LRU[K, V]{
+		config:   newConfig(opts),
+		capacity: capacity,
+		items:    make(map[K]*linkedlist.Node[entry[K, V]]),
+		ll:       linkedlist.New[entry[K, V]](),
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet removed.
+func (c *LRU[K, V]) Len() int {
+	return c.ll.Len()
+}
+
+// Used returns the sum of costs of entries.
+func (c *LRU[K, V]) Used() int {
+	return c.used
+}
+
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *LRU[K, V]) expired(n *linkedlist.Node[entry[K, V]]) bool {
+	return !n.Value.expires.IsZero() && !c.now().Before(n.Value.expires)
+}
+
+// Get returns the value of key and marks it most recently used.
+func (c *LRU[K, V]) Get(key K) (value V, ok bool) {
+	n, ok := c.items[key]
+	if ok && c.expired(n) {
+		c.expire(n)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		return
+	}
+	c.stats.Hits++
+	c.ll.MoveToFront(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value of key without marking it used or counting stats.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.items[key]
+	if !ok || c.expired(n) {
+		var value V
+		return value, false
+	}
+	return n.Value.value, true
+}
+
+// Contains reports whether key is cached without marking it used.
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// Put caches the value with the default time to live.
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.PutTTL(key, value, c.ttl)
+}
+
+// PutTTL caches the value expiring after ttl, zero never expires. Least
+// recently used entries are evicted until the cache fits its capacity, an
+// entry costing more than the capacity is evicted at once.
+func (c *LRU[K, V]) PutTTL(key K, value V, ttl time.Duration) {
+	e := entry[K, V]{key: key, value: value, cost: c.cost(key, value)}
+	if ttl > 0 {
+		e.expires = c.now().Add(ttl)
+	}
+	if e.cost > c.capacity {
+		// never fits, evicting others would not help
+		if n, ok := c.items[key]; ok {
+			c.remove(n)
+		}
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(key, value)
+		}
+		return
+	}
+	if n, ok := c.items[key]; ok {
+		c.used += e.cost - n.Value.cost
+		n.Value = e
+		c.ll.MoveToFront(n)
+	} else {
+		c.items[key] = c.ll.PushFront(e)
+		c.used += e.cost
+	}
+	for c.used > c.capacity {
+		c.evict(c.ll.Back())
+	}
+}
+
+// Remove deletes key, reports false if key is not cached.
+func (c *LRU[K, V]) Remove(key K) bool {
+	n, ok := c.items[key]
+	if ok {
+		c.remove(n)
+	}
+	return ok
+}
+
+// RemoveExpired deletes all expired entries, returns the number of them.
+func (c *LRU[K, V]) RemoveExpired() int {
+	removed := 0
+	c.ll.ForEach(func(n *linkedlist.Node[entry[K, V]]) {
+		if c.expired(n) {
+			c.expire(n)
+			removed++
+		}
+	})
+	return removed
+}
+
+// Keys returns the keys from most to least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	keys := make([]K, 0, c.ll.Len())
+	c.ll.Range(func(e entry[K, V]) bool {
+		keys = append(keys, e.key)
+		return true
+	})
+	return keys
+}
+
+// Clear removes all entries, stats are kept.
+func (c *LRU[K, V]) Clear() {
+	clear(c.items)
+	c.ll.Clear()
+	c.used = 0
+}
+
+func (c *LRU[K, V]) remove(n *linkedlist.Node[entry[K, V]]) {
+	delete(c.items, n.Value.key)
+	c.ll.Remove(n)
+	c.used -= n.Value.cost
+}
+
+func (c *LRU[K, V]) evict(n *linkedlist.Node[entry[K, V]]) {
+	c.remove(n)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(n.Value.key, n.Value.value)
+	}
+}
+
+func (c *LRU[K, V]) expire(n *linkedlist.Node[entry[K, V]]) {
+	c.remove(n)
+	c.stats.Expirations++
+	if c.onEvict != nil {
+		c.onEvict(n.Value.key, n.Value.value)
+	}
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"bufio"
+	"io"
+)
+
+// Replay requests every key of the trace in order as a read-through
+// client would, looking it up and putting the value produced by load on
+// a miss. It returns the stats accumulated during the replay.
+func Replay[K comparable, V any](c Cache[K, V], trace []K, load func(key K) V) Stats {
+	before := c.Stats()
+	for _, key := range trace {
+		if _, ok := c.Get(key); !ok {
+			c.Put(key, load(key))
+		}
+	}
+	after := c.Stats()
+	return Stats{
+		Hits:        after.Hits - before.Hits,
+		Misses:      after.Misses - before.Misses,
+		Evictions:   after.Evictions - before.Evictions,
+		Expirations: after.Expirations - before.Expirations,
+	}
+}
+
+// ReadTrace reads a recorded trace of one key per line.
+func ReadTrace(r io.Reader) ([]string, error) {
+	var trace []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if key := s.Text(); key != "" {
+			trace = append(trace, key)
+		}
+	}
+	return trace, s.Err()
+}
@@ -0,0 +1,164 @@
+package cache
+
+import "go-data-structure/list/linkedlist"
+
+type twoQEntry[K comparable, V any] struct {
+	key   K
+	value V
+	// list holding the entry
+	in *linkedlist.LinkedList[*twoQEntry[K, V]]
+}
+
+// TwoQueue is a 2Q cache. New entries enter a FIFO queue 'in' and only
+// entries requested again, while in it or after leaving it remembered by
+// key in the ghost queue 'out', are admitted to the LRU list 'main', so a
+// scan only churns 'in'.
+type TwoQueue[K comparable, V any] struct {
+	config[K, V]
+	capacity int
+	// sizes of 'in' and 'out'
+	kin, kout int
+	// most recent first
+	in, out, main *linkedlist.LinkedList[*twoQEntry[K, V]]
+	items         map[K]*linkedlist.Node[*twoQEntry[K, V]]
+	stats         Stats
+}
+
+// NewTwoQueue returns a cache holding up to 'capacity' entries, a quarter
+// of them in the FIFO queue, and remembering half as many evicted keys.
+// It panics on WithCost and WithTTL.
+func NewTwoQueue[K comparable, V any](capacity int, opts ...Option[K, V]) *TwoQueue[K, V] {
+	if capacity < 1 {
+		panic("cache: invalid capacity, should be at least 1")
+	}
+	return &TwoQueue[K, V]{
+		config:   newCountConfig("2Q", opts),
+		capacity: capacity,
+		kin:      max(capacity/4, 1),
+		kout:     max(capacity/2, 1),
+		in:       linkedlist.New[*twoQEntry[K, V]](),
+		out:      linkedlist.New[*twoQEntry[K, V]](),
+		main:     linkedlist.New[*twoQEntry[K, V]](),
+		items:    make(map[K]*linkedlist.Node[*twoQEntry[K, V]]),
+	}
+}
+
+func (c *TwoQueue[K, V]) Len() int {
+	return c.in.Len() + c.main.Len()
+}
+
+func (c *TwoQueue[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// cached returns the node of key if its value is cached, not a ghost.
+func (c *TwoQueue[K, V]) cached(key K) (*linkedlist.Node[*twoQEntry[K, V]], bool) {
+	n, ok := c.items[key]
+	if !ok || n.Value.in == c.out {
+		return nil, false
+	}
+	return n, true
+}
+
+// Get returns the value of key and admits it to 'main' as most recently used.
+func (c *TwoQueue[K, V]) Get(key K) (value V, ok bool) {
+	n, ok := c.cached(key)
+	if !ok {
+		c.stats.Misses++
+		return
+	}
+	c.stats.Hits++
+	c.promote(n)
+	return n.Value.value, true
+}
+
+func (c *TwoQueue[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.cached(key)
+	if !ok {
+		var value V
+		return value, false
+	}
+	return n.Value.value, true
+}
+
+func (c *TwoQueue[K, V]) Contains(key K) bool {
+	_, ok := c.cached(key)
+	return ok
+}
+
+func (c *TwoQueue[K, V]) Put(key K, value V) {
+	n, ok := c.items[key]
+	switch {
+	case ok && n.Value.in != c.out:
+		n.Value.value = value
+		c.promote(n)
+	case ok:
+		// requested again after leaving 'in', the ghost must survive reclaim
+		c.out.Remove(n)
+		delete(c.items, key)
+		c.reclaim()
+		c.items[key] = c.main.PushFront(&twoQEntry[K, V]{key: key, value: value, in: c.main})
+	default:
+		c.reclaim()
+		c.items[key] = c.in.PushFront(&twoQEntry[K, V]{key: key, value: value, in: c.in})
+	}
+}
+
+func (c *TwoQueue[K, V]) Remove(key K) bool {
+	n, ok := c.cached(key)
+	if ok {
+		n.Value.in.Remove(n)
+		delete(c.items, key)
+	}
+	return ok
+}
+
+func (c *TwoQueue[K, V]) Clear() {
+	c.in.Clear()
+	c.out.Clear()
+	c.main.Clear()
+	clear(c.items)
+}
+
+// promote moves a cached entry to the front of 'main'.
+func (c *TwoQueue[K, V]) promote(n *linkedlist.Node[*twoQEntry[K, V]]) {
+	if n.Value.in == c.main {
+		c.main.MoveToFront(n)
+		return
+	}
+	c.main.SpliceRange(n.Value.in, n, n, c.main.Front())
+	n.Value.in = c.main
+}
+
+// reclaim evicts an entry if the cache is full, the oldest of 'in' into
+// the ghost queue if 'in' is over its size, orelse the least recently
+// used of 'main'.
+func (c *TwoQueue[K, V]) reclaim() {
+	if c.Len() < c.capacity {
+		return
+	}
+	var e *twoQEntry[K, V]
+	if c.in.Len() > c.kin || c.main.Len() == 0 {
+		n := c.in.Back()
+		e = n.Value
+		c.out.SpliceRange(c.in, n, n, c.out.Front())
+		e.in = c.out
+		if c.out.Len() > c.kout {
+			oldest := c.out.Back()
+			c.out.Remove(oldest)
+			delete(c.items, oldest.Value.key)
+		}
+	} else {
+		n := c.main.Back()
+		e = n.Value
+		c.main.Remove(n)
+		delete(c.items, e.key)
+	}
+	value := e.value
+	var zero V
+	e.value = zero
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, value)
+	}
+}
@@ -0,0 +1,137 @@
+package concurrent
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/list/arraylist"
+	"go-data-structure/list/linkedlist"
+	"go-data-structure/list/listtest"
+	"go-data-structure/list/skiplist"
+	"go-data-structure/tree/avltree"
+	"go-data-structure/tree/btree"
+	"go-data-structure/tree/maptest"
+)
+
+func ExampleSortedMap_GetOrPut() {
+	m := NewSortedMap[string, int](avltree.New[string, int]())
+	fmt.Println(m.GetOrPut("a", 1))
+	fmt.Println(m.GetOrPut("a", 2))
+	// Output:
+	// 1 false
+	// 1 true
+}
+
+func ExampleSortedMap_CompareAndSwap() {
+	m := NewSortedMap[string, int](btree.New[string, int](3))
+	m.Put("a", 1)
+	equal := list.Ordered[int]().Equaler()
+	fmt.Println(m.CompareAndSwap("a", 2, 3, equal))
+	fmt.Println(m.CompareAndSwap("a", 1, 3, equal))
+	fmt.Println(m.Get("a"))
+	// Output:
+	// false
+	// true
+	// 3 true
+}
+
+func ExampleList_Range() {
+	l := NewList[int](arraylist.New(1, 2, 3))
+	// callbacks run on a snapshot without the lock and may modify the list
+	l.Range(func(e int) bool {
+		l.Append(e * 10)
+		return true
+	})
+	fmt.Println(l.Snapshot())
+	// Output:
+	// [1 2 3 10 20 30]
+}
+
+func TestList(t *testing.T) {
+	listtest.TestList(t, func() list.List[int] { return NewList[int](arraylist.New[int]()) })
+	listtest.TestList(t, func() list.List[int] { return NewList[int](linkedlist.New[int]()) })
+}
+
+func TestSet(t *testing.T) {
+	listtest.TestSet(t, func() list.Set[int] { return NewSet[int](skiplist.NewSet(list.Ordered[int]())) })
+}
+
+func TestSortedMap(t *testing.T) {
+	maptest.TestSortedMap(t, func() list.SortedMap[int, string] {
+		return NewSortedMap[int, string](skiplist.NewMap[int, string](list.Ordered[int]()))
+	})
+}
+
+func TestConcurrentUse(t *testing.T) {
+	const goroutines, ops = 8, 1000
+	l := NewList[int](arraylist.New[int]())
+	m := NewSortedMap[int, int](avltree.New[int, int]())
+	s := NewSet[int](skiplist.NewSet(list.Ordered[int]()))
+	m.Put(-1, 0)
+	var computed atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				l.Append(i)
+				l.Update(0, func(e int) int { return e + 1 })
+				m.ComputeIfAbsent(i%100, func(key int) int {
+					computed.Add(1)
+					return key
+				})
+				for {
+					v, _ := m.Get(-1)
+					if m.CompareAndSwap(-1, v, v+1, list.Ordered[int]().Equaler()) {
+						break
+					}
+				}
+				s.Add(i)
+				m.Range(func(key, value int) bool { return key < 10 })
+			}
+		}(g)
+	}
+	wg.Wait()
+	if l.Len() != goroutines*ops {
+		t.Errorf("list Len() = %d, want %d", l.Len(), goroutines*ops)
+	}
+	if computed.Load() != 100 {
+		t.Errorf("ComputeIfAbsent computed %d values, want 100", computed.Load())
+	}
+	if v, _ := m.Get(-1); v != goroutines*ops {
+		t.Errorf("CompareAndSwap counter = %d, want %d", v, goroutines*ops)
+	}
+	if s.Len() != ops {
+		t.Errorf("set Len() = %d, want %d", s.Len(), ops)
+	}
+}
+
+// separate wrappers share no lock, so the lists under them must not share
+// state either, run with -race
+func TestIndependentWrappers(t *testing.T) {
+	const ops = 1000
+	m := NewSortedMap[int, int](skiplist.NewMap[int, int](list.Ordered[int]()))
+	s := NewSet[int](skiplist.NewSet(list.Ordered[int]()))
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < ops; i++ {
+			m.Put(i, i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < ops; i++ {
+			s.Add(i)
+		}
+	}()
+	wg.Wait()
+	if m.Len() != ops || s.Len() != ops {
+		t.Errorf("map Len() = %d, set Len() = %d, want %d", m.Len(), s.Len(), ops)
+	}
+}
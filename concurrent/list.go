@@ -0,0 +1,127 @@
+// Package concurrent implements wrappers making the containers of the
+// library safe for concurrent use.
+//
+// Every wrapper guards a container with a sync.RWMutex, exposes the same
+// interface as the container and adds atomic compound operations. Range
+// iterates over a snapshot taken under the lock, so callbacks run without
+// holding it and may call back into the wrapper.
+//
+// A wrapped container must not be accessed directly afterwards.
+package concurrent
+
+import (
+	"sync"
+
+	"go-data-structure/list"
+)
+
+var _ list.List[int] = (*List[int])(nil)
+
+// List is a list safe for concurrent use.
+type List[T any] struct {
+	mu sync.RWMutex
+	l  list.List[T]
+}
+
+// NewList wraps a list such as *arraylist.ArrayList or *linkedlist.LinkedList.
+func NewList[T any](l list.List[T]) *List[T] {
+	return &List[T]{l: l}
+}
+
+func (s *List[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Len()
+}
+
+func (s *List[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Clear()
+}
+
+func (s *List[T]) Get(idx int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Get(idx)
+}
+
+func (s *List[T]) Set(idx int, e T) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.Set(idx, e)
+}
+
+func (s *List[T]) Insert(idx int, e ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Insert(idx, e...)
+}
+
+func (s *List[T]) Append(e ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Append(e...)
+}
+
+func (s *List[T]) RemoveAt(idx int) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.RemoveAt(idx)
+}
+
+// Snapshot returns a copy of the elements.
+func (s *List[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return snapshot[T](s.l)
+}
+
+// Range calls fn for each element of a snapshot until fn returns false.
+func (s *List[T]) Range(fn func(e T) bool) {
+	for _, e := range s.Snapshot() {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// CompareAndSwap replaces the element at idx with 'new' if it equals 'old',
+// reports whether it was replaced.
+func (s *List[T]) CompareAndSwap(idx int, old, new T, equal list.Equaler[T]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.l.Get(idx); !ok || !equal(e, old) {
+		return false
+	}
+	s.l.Set(idx, new)
+	return true
+}
+
+// Update replaces the element at idx with the result of fn, false if out of range.
+func (s *List[T]) Update(idx int, fn func(e T) T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.l.Get(idx)
+	if ok {
+		s.l.Set(idx, fn(e))
+	}
+	return ok
+}
+
+// Do calls fn with the wrapped list under the write lock, for compound
+// operations not covered by the wrapper. fn must not retain the list.
+func (s *List[T]) Do(fn func(l list.List[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.l)
+}
+
+func snapshot[T any](s list.Sequence[T]) []T {
+	es := make([]T, 0, s.Len())
+	s.Range(func(e T) bool {
+		es = append(es, e)
+		return true
+	})
+	return es
+}
@@ -0,0 +1,144 @@
+package concurrent
+
+import (
+	"sync"
+
+	"go-data-structure/list"
+)
+
+var _ list.SortedMap[int, int] = (*SortedMap[int, int])(nil)
+
+// SortedMap is a sorted map safe for concurrent use.
+type SortedMap[K, V any] struct {
+	mu sync.RWMutex
+	m  list.SortedMap[K, V]
+}
+
+// NewSortedMap wraps a map such as *avltree.AvlTree, *btree.BTree or *skiplist.SkipMap.
+func NewSortedMap[K, V any](m list.SortedMap[K, V]) *SortedMap[K, V] {
+	return &SortedMap[K, V]{m: m}
+}
+
+func (s *SortedMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Len()
+}
+
+func (s *SortedMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
+
+func (s *SortedMap[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Put(key, value)
+}
+
+func (s *SortedMap[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+func (s *SortedMap[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Remove(key)
+}
+
+func (s *SortedMap[K, V]) Min() (K, V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Min()
+}
+
+func (s *SortedMap[K, V]) Max() (K, V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Max()
+}
+
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// Range calls fn for each entry of a snapshot in ascending key order
+// until fn returns false.
+func (s *SortedMap[K, V]) Range(fn func(key K, value V) bool) {
+	s.mu.RLock()
+	entries := make([]entry[K, V], 0, s.m.Len())
+	s.m.Range(func(key K, value V) bool {
+		entries = append(entries, entry[K, V]{key, value})
+		return true
+	})
+	s.mu.RUnlock()
+
+	for _, e := range entries {
+		if !fn(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// GetOrPut returns the value of key if present, orelse puts and returns
+// 'value'. loaded reports whether the value was present.
+func (s *SortedMap[K, V]) GetOrPut(key K, value V) (actual V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m.Get(key); ok {
+		return v, true
+	}
+	s.m.Put(key, value)
+	return value, false
+}
+
+// ComputeIfAbsent returns the value of key if present, orelse puts and
+// returns the result of fn, which runs at most once per absent key under
+// the write lock and must not call back into the map.
+func (s *SortedMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	if v, ok := s.Get(key); ok {
+		return v
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m.Get(key); ok {
+		return v
+	}
+	v := fn(key)
+	s.m.Put(key, v)
+	return v
+}
+
+// CompareAndSwap replaces the value of key with 'new' if it equals 'old',
+// reports whether it was replaced.
+func (s *SortedMap[K, V]) CompareAndSwap(key K, old, new V, equal list.Equaler[V]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m.Get(key); !ok || !equal(v, old) {
+		return false
+	}
+	s.m.Put(key, new)
+	return true
+}
+
+// CompareAndRemove removes key if its value equals 'old', reports whether it was removed.
+func (s *SortedMap[K, V]) CompareAndRemove(key K, old V, equal list.Equaler[V]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m.Get(key); !ok || !equal(v, old) {
+		return false
+	}
+	return s.m.Remove(key)
+}
+
+// Do calls fn with the wrapped map under the write lock, for compound
+// operations not covered by the wrapper. fn must not retain the map.
+func (s *SortedMap[K, V]) Do(fn func(m list.SortedMap[K, V])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.m)
+}
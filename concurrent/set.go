@@ -0,0 +1,74 @@
+package concurrent
+
+import (
+	"sync"
+
+	"go-data-structure/list"
+)
+
+var _ list.Set[int] = (*Set[int])(nil)
+
+// Set is a set safe for concurrent use.
+type Set[T any] struct {
+	mu sync.RWMutex
+	s  list.Set[T]
+}
+
+// NewSet wraps a set such as *skiplist.SkipSet.
+func NewSet[T any](s list.Set[T]) *Set[T] {
+	return &Set[T]{s: s}
+}
+
+func (s *Set[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Len()
+}
+
+func (s *Set[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Clear()
+}
+
+func (s *Set[T]) Add(e T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Add(e)
+}
+
+func (s *Set[T]) Remove(e T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Remove(e)
+}
+
+func (s *Set[T]) Contains(e T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Contains(e)
+}
+
+// Snapshot returns a copy of the elements.
+func (s *Set[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return snapshot[T](s.s)
+}
+
+// Range calls fn for each element of a snapshot until fn returns false.
+func (s *Set[T]) Range(fn func(e T) bool) {
+	for _, e := range s.Snapshot() {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// Do calls fn with the wrapped set under the write lock, for compound
+// operations not covered by the wrapper. fn must not retain the set.
+func (s *Set[T]) Do(fn func(s list.Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.s)
+}
@@ -0,0 +1,31 @@
+package owner
+
+import "testing"
+
+type box struct{ name string }
+
+func TestForward(t *testing.T) {
+	a, b, c := &box{"a"}, &box{"b"}, &box{"c"}
+	oa, ob, oc := New(a), New(b), New(c)
+	tagged := oa
+	// a -> b -> c
+	oa = oa.Forward(ob)
+	ob = ob.Forward(oc)
+	if got := Resolve(tagged).Container(); got != c {
+		t.Fatalf("node of a resolves to %v, want c", got)
+	}
+	if tagged.next != oc {
+		t.Fatalf("forwarding path not compressed")
+	}
+	if oa.Container() != a || ob.Container() != b {
+		t.Fatalf("emptied containers lost their fresh owners")
+	}
+	released := oc
+	oc = oc.Release()
+	if Resolve(tagged).Container() != nil || Resolve(released).Container() != nil {
+		t.Fatalf("nodes of a released owner still resolve to a container")
+	}
+	if oc.Container() != c || Resolve[box](nil).Container() != nil {
+		t.Fatalf("fresh owner does not identify c")
+	}
+}
@@ -0,0 +1,68 @@
+// Package owner tracks the container holding a node, so that a container
+// can hand all of its nodes over to another one in O(1).
+//
+// Every node is tagged with the owner of its container. Forwarding an
+// owner to the one of another container moves every node tagged with it
+// at once, nodes find their current owner by following the forwarding
+// path, which Resolve compresses like a union-find.
+package owner
+
+// Owner identifies a container of type C.
+type Owner[C any] struct {
+	container *C
+	next      *Owner[C]
+}
+
+// New returns an owner identifying the container.
+func New[C any](container *C) *Owner[C] {
+	return &Owner[C]{container: container}
+}
+
+// Find returns the current owner of a node tagged with 'o', nil if 'o' is
+// nil. It only reads, concurrent lookups of unmodified containers are safe.
+func Find[C any](o *Owner[C]) *Owner[C] {
+	if o == nil {
+		return nil
+	}
+	for o.next != nil {
+		o = o.next
+	}
+	return o
+}
+
+// Resolve is Find compressing the forwarding path, callers must hold the
+// container for writing.
+func Resolve[C any](o *Owner[C]) *Owner[C] {
+	root := Find(o)
+	for o != root {
+		next := o.next
+		o.next = root
+		o = next
+	}
+	return root
+}
+
+// Container returns the container identified by the owner, nil if the
+// owner is nil or released.
+func (o *Owner[C]) Container() *C {
+	if o == nil {
+		return nil
+	}
+	return o.container
+}
+
+// Release detaches the nodes tagged with the owner from its container and
+// returns a fresh owner for the container.
+func (o *Owner[C]) Release() *Owner[C] {
+	c := o.container
+	o.container = nil
+	return New(c)
+}
+
+// Forward hands the nodes tagged with the owner over to the container of
+// 'to' and returns a fresh owner for the emptied container.
+func (o *Owner[C]) Forward(to *Owner[C]) *Owner[C] {
+	c := o.container
+	o.container, o.next = nil, to
+	return New(c)
+}
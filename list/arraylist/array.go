@@ -1,9 +1,19 @@
 package arraylist
 
+import "go-data-structure/list"
+
 const DEFAULT_CAP = 32
 
+var _ list.List[int] = (*ArrayList[int])(nil)
+
 type ArrayList[T any] struct {
 	elements []T
+	// counts structural modifications to detect stale sub-lists
+	mod int
+	// capacity management, see Option
+	minCap int
+	grow   GrowthPolicy
+	shrink float64
 }
 
 func New[T any](e ...T) *ArrayList[T] {
@@ -23,14 +33,21 @@ func (l *ArrayList[T]) Cap() int {
 }
 
 func (l *ArrayList[T]) Append(e ...T) {
+	l.mod++
+	l.ensure(len(e))
 	l.elements = append(l.elements, e...)
 }
 
 func (l *ArrayList[T]) Insert(idx int, e ...T) {
+	l.mod++
+	l.ensure(len(e))
 	if idx < 0 || idx >= l.Len() {
 		l.elements = append(l.elements, e...)
 	} else {
-		l.elements = append(l.elements[:idx], append(e, l.elements[idx:]...)...)
+		n := l.Len()
+		l.elements = append(l.elements, e...)
+		copy(l.elements[idx+len(e):], l.elements[idx:n])
+		copy(l.elements[idx:], e)
 	}
 }
 
@@ -57,7 +74,36 @@ func (l *ArrayList[T]) Remove(idx int) {
 	if idx < 0 || idx >= l.Len() {
 		return
 	}
+	l.mod++
 	l.elements = l.elements[:idx+copy(l.elements[idx:], l.elements[idx+1:])]
+	l.release()
+}
+
+// returns the removed element or empty of 'T' if out of range list
+func (l *ArrayList[T]) RemoveAt(idx int) (T, bool) {
+	var e T
+	if idx < 0 || idx >= l.Len() {
+		return e, false
+	}
+	e = l.elements[idx]
+	l.Remove(idx)
+	return e, true
+}
+
+// removes all elements, keeps the allocated capacity unless automatic shrinking is enabled
+func (l *ArrayList[T]) Clear() {
+	l.mod++
+	clear(l.elements)
+	l.elements = l.elements[:0]
+	l.release()
+}
+
+func (l *ArrayList[T]) Range(fn func(e T) bool) {
+	for _, e := range l.elements {
+		if !fn(e) {
+			return
+		}
+	}
 }
 
 func (l *ArrayList[T]) Foreach(fn func(e T)) {
@@ -0,0 +1,100 @@
+package arraylist
+
+// removes elements in range [from, to), the range is clamped to the list
+func (l *ArrayList[T]) RemoveRange(from, to int) {
+	from, to = max(from, 0), min(to, l.Len())
+	if from >= to {
+		return
+	}
+	l.mod++
+	n := copy(l.elements[from:], l.elements[to:])
+	clear(l.elements[from+n:])
+	l.elements = l.elements[:from+n]
+	l.release()
+}
+
+// removes all elements satisfying 'pred' in a single pass, returns the number of removed elements
+func (l *ArrayList[T]) RemoveIf(pred func(e T) bool) int {
+	n := 0
+	for _, e := range l.elements {
+		if !pred(e) {
+			l.elements[n] = e
+			n++
+		}
+	}
+	removed := l.Len() - n
+	if removed > 0 {
+		l.mod++
+	}
+	clear(l.elements[n:])
+	l.elements = l.elements[:n]
+	l.release()
+	return removed
+}
+
+// keeps only elements satisfying 'pred', returns the number of removed elements
+func (l *ArrayList[T]) Retain(pred func(e T) bool) int {
+	return l.RemoveIf(func(e T) bool { return !pred(e) })
+}
+
+func (l *ArrayList[T]) Reverse() {
+	reverse(l.elements)
+}
+
+// rotates elements 'k' positions towards the end, negative 'k' rotates towards the front
+func (l *ArrayList[T]) Rotate(k int) {
+	n := l.Len()
+	if n == 0 {
+		return
+	}
+	k = ((k % n) + n) % n
+	if k == 0 {
+		return
+	}
+	reverse(l.elements)
+	reverse(l.elements[:k])
+	reverse(l.elements[k:])
+}
+
+// reduces capacity to the length, a cleared list releases the whole backing array
+func (l *ArrayList[T]) Shrink() {
+	if l.Cap() == l.Len() {
+		return
+	}
+	l.realloc(l.Len())
+}
+
+func reverse[T any](es []T) {
+	for i, j := 0, len(es)-1; i < j; i, j = i+1, j-1 {
+		es[i], es[j] = es[j], es[i]
+	}
+}
+
+// returns a new list of 'fn' applied to each element of 'l'
+func Map[T, U any](l *ArrayList[T], fn func(e T) U) *ArrayList[U] {
+	m := &ArrayList[U]{elements: make([]U, 0, max(l.Len(), DEFAULT_CAP))}
+	for _, e := range l.elements {
+		m.elements = append(m.elements, fn(e))
+	}
+	return m
+}
+
+// returns a new list of elements of 'l' satisfying 'pred'
+func Filter[T any](l *ArrayList[T], pred func(e T) bool) *ArrayList[T] {
+	f := New[T]()
+	for _, e := range l.elements {
+		if pred(e) {
+			f.elements = append(f.elements, e)
+		}
+	}
+	return f
+}
+
+// folds elements of 'l' from front to back into an accumulator starting at 'init'
+func Reduce[T, A any](l *ArrayList[T], init A, fn func(acc A, e T) A) A {
+	acc := init
+	for _, e := range l.elements {
+		acc = fn(acc, e)
+	}
+	return acc
+}
@@ -1,6 +1,14 @@
 package arraylist
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/list/listtest"
+)
 
 func Output(l *ArrayList[int]) {
 	fmt.Println(l.elements)
@@ -93,3 +101,305 @@ func ExampleArrayList_Contains() {
 	// 1 true
 	// -1 false
 }
+
+func TestArrayList(t *testing.T) {
+	listtest.TestList(t, func() list.List[int] { return New[int]() })
+}
+
+func ExampleArrayList_Sort() {
+	l := New(3, 1, 5, 2, 4)
+	l.Sort(list.Ordered[int]().Less())
+	Output(l)
+	fmt.Println(l.IsSorted(list.Ordered[int]().Less()))
+	// Output:
+	// [1 2 3 4 5]
+	// true
+}
+
+func ExampleArrayList_SortStable() {
+	l := New(13, 21, 12, 23, 11)
+	l.SortStable(list.By(func(e int) int { return e / 10 }).Less())
+	Output(l)
+	// Output:
+	// [13 12 11 21 23]
+}
+
+func ExampleArrayList_BinarySearch() {
+	l := New(1, 3, 3, 5)
+	fmt.Println(l.BinarySearch(3, list.Ordered[int]()))
+	fmt.Println(l.BinarySearch(4, list.Ordered[int]()))
+	fmt.Println(l.BinarySearch(9, list.Ordered[int]()))
+	// Output:
+	// 1 true
+	// 3 false
+	// 4 false
+}
+
+func ExampleArrayList_SortedInsert() {
+	l := New[int]()
+	for _, e := range []int{5, 1, 4, 1, 3} {
+		l.SortedInsert(e, list.Ordered[int]())
+	}
+	Output(l)
+	// Output:
+	// [1 1 3 4 5]
+}
+
+func ExampleArrayList_RemoveRange() {
+	l := New(1, 2, 3, 4, 5)
+	l.RemoveRange(1, 3)
+	Output(l)
+	l.RemoveRange(2, 10)
+	Output(l)
+	// Output:
+	// [1 4 5]
+	// [1 4]
+}
+
+func ExampleArrayList_RemoveIf() {
+	l := New(1, 2, 3, 4, 5, 6)
+	n := l.RemoveIf(func(e int) bool { return e%2 == 0 })
+	Output(l)
+	fmt.Println(n)
+	// Output:
+	// [1 3 5]
+	// 3
+}
+
+func ExampleArrayList_Retain() {
+	l := New(1, 2, 3, 4, 5, 6)
+	l.Retain(func(e int) bool { return e > 3 })
+	Output(l)
+	// Output:
+	// [4 5 6]
+}
+
+func ExampleArrayList_Reverse() {
+	l := New(1, 2, 3, 4, 5)
+	l.Reverse()
+	Output(l)
+	// Output:
+	// [5 4 3 2 1]
+}
+
+func ExampleArrayList_Rotate() {
+	l := New(1, 2, 3, 4, 5)
+	l.Rotate(2)
+	Output(l)
+	l.Rotate(-3)
+	Output(l)
+	// Output:
+	// [4 5 1 2 3]
+	// [2 3 4 5 1]
+}
+
+func ExampleArrayList_Clear() {
+	l := New(1, 2, 3, 4, 5)
+	l.Clear()
+	fmt.Println(l.Len(), l.Cap())
+	l.Shrink()
+	fmt.Println(l.Len(), l.Cap())
+	// Output:
+	// 0 32
+	// 0 0
+}
+
+func ExampleArrayList_Shrink() {
+	l := New(1, 2, 3)
+	l.Shrink()
+	fmt.Println(l.Len(), l.Cap())
+	// Output:
+	// 3 3
+}
+
+func ExampleMap() {
+	l := Map(New(1, 2, 3), func(e int) string { return strings.Repeat("*", e) })
+	fmt.Println(l.elements)
+	// Output:
+	// [* ** ***]
+}
+
+func ExampleFilter() {
+	l := Filter(New(1, 2, 3, 4, 5), func(e int) bool { return e%2 == 1 })
+	Output(l)
+	// Output:
+	// [1 3 5]
+}
+
+func ExampleReduce() {
+	sum := Reduce(New(1, 2, 3, 4, 5), 0, func(acc, e int) int { return acc + e })
+	fmt.Println(sum)
+	// Output:
+	// 15
+}
+
+func ExampleArrayList_Strict() {
+	s := New(1, 2, 3).Strict()
+	if err := s.Insert(3, 4); err != nil {
+		fmt.Println(err)
+	}
+	if err := s.Insert(5, 6); err != nil {
+		fmt.Println(err)
+	}
+	if _, err := s.Remove(-1); errors.Is(err, ErrIndexOutOfRange) {
+		var ie *IndexError
+		errors.As(err, &ie)
+		fmt.Println(ie.Index, ie.Len)
+	}
+	e, err := s.Set(0, 9)
+	fmt.Println(e, err)
+	Output(s.List())
+	// Output:
+	// arraylist: index 5 out of range [0:4]
+	// -1 4
+	// 1 <nil>
+	// [9 2 3 4]
+}
+
+func ExampleStrict_Get() {
+	s := New(1, 2, 3).Strict()
+	fmt.Println(s.Get(1))
+	fmt.Println(s.Get(3))
+	// Output:
+	// 2 <nil>
+	// 0 arraylist: index 3 out of range [0:3)
+}
+
+func ExampleArrayList_SubList() {
+	l := New(1, 2, 3, 4, 5)
+	s, _ := l.SubList(1, 4)
+	s.Set(0, 20)
+	s.Range(func(e int) bool {
+		fmt.Println(e)
+		return true
+	})
+	Output(l)
+
+	l.Append(6)
+	_, err := s.Get(0)
+	fmt.Println(err)
+	_, err = l.SubList(2, 9)
+	fmt.Println(err)
+	// Output:
+	// 20
+	// 3
+	// 4
+	// [1 20 3 4 5]
+	// arraylist: concurrent modification of parent list
+	// arraylist: index 9 out of range [0:6]
+}
+
+func ExampleSubList_SubList() {
+	l := New(1, 2, 3, 4, 5)
+	s, _ := l.SubList(1, 5)
+	s, _ = s.SubList(1, 3)
+	fmt.Println(s.Len())
+	fmt.Println(s.Get(0))
+	fmt.Println(s.Get(2))
+	// Output:
+	// 2
+	// 3 <nil>
+	// 0 arraylist: index 2 out of range [0:2)
+}
+
+func ExampleNewWith() {
+	l := NewWith[int](WithCapacity(2), WithGrowthFactor(1.5))
+	for i := 0; i < 10; i++ {
+		l.Append(i)
+		fmt.Printf("%d ", l.Cap())
+	}
+	// Output:
+	// 2 2 4 4 7 7 7 11 11 11
+}
+
+func ExampleWithShrinkThreshold() {
+	l := NewWith[int](WithCapacity(4), WithShrinkThreshold(0.25))
+	l.Reserve(100)
+	l.Append(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	fmt.Println(l.Cap())
+	l.RemoveRange(0, 8)
+	fmt.Println(l.Cap())
+	l.Clear()
+	fmt.Println(l.Cap())
+	// Output:
+	// 100
+	// 4
+	// 4
+}
+
+func ExampleArrayList_Reserve() {
+	l := New[int]()
+	l.Reserve(100)
+	fmt.Println(l.Len(), l.Cap())
+	l.Reserve(10)
+	fmt.Println(l.Len(), l.Cap())
+	// Output:
+	// 0 100
+	// 0 100
+}
+
+func BenchmarkAppend(b *testing.B) {
+	const n = 100000
+	cases := []struct {
+		name string
+		opts []Option
+	}{
+		{"Default", nil},
+		{"Factor1.25", []Option{WithGrowthFactor(1.25)}},
+		{"Factor4", []Option{WithGrowthFactor(4)}},
+		{"Step1024", []Option{WithGrowthPolicy(GrowthStep(1024))}},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+			var l *ArrayList[int]
+			for i := 0; i < b.N; i++ {
+				l = NewWith[int](c.opts...)
+				for j := 0; j < n; j++ {
+					l.Append(j)
+				}
+			}
+			b.ReportMetric(float64(l.Cap())/float64(l.Len()), "cap/len")
+		})
+	}
+}
+
+func BenchmarkTinyLists(b *testing.B) {
+	const lists = 10000
+	for _, capacity := range []int{0, 4, DEFAULT_CAP} {
+		b.Run(fmt.Sprintf("Cap%d", capacity), func(b *testing.B) {
+			b.ReportAllocs()
+			ls := make([]*ArrayList[int], lists)
+			for i := 0; i < b.N; i++ {
+				for j := range ls {
+					ls[j] = NewWith[int](WithCapacity(capacity))
+					ls[j].Append(j, j)
+				}
+			}
+			b.ReportMetric(float64(ls[0].Cap()*8), "bytes/list")
+		})
+	}
+}
+
+func BenchmarkRemoveShrink(b *testing.B) {
+	const n = 100000
+	for _, ratio := range []float64{0, 0.25} {
+		b.Run(fmt.Sprintf("Threshold%v", ratio), func(b *testing.B) {
+			b.ReportAllocs()
+			var peak, final int
+			for i := 0; i < b.N; i++ {
+				l := NewWith[int](WithShrinkThreshold(ratio))
+				for j := 0; j < n; j++ {
+					l.Append(j)
+				}
+				peak = l.Cap()
+				for l.Len() > 0 {
+					l.Remove(l.Len() - 1)
+				}
+				final = l.Cap()
+			}
+			b.ReportMetric(float64(peak), "peak-cap")
+			b.ReportMetric(float64(final), "final-cap")
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package arraylist
+
+// GrowthPolicy returns the new capacity of a list of capacity 'cap'
+// which must hold at least 'need' elements, smaller results are raised to 'need'.
+type GrowthPolicy func(cap, need int) int
+
+// GrowthFactor returns a policy multiplying capacity by 'factor' which must be greater than 1.
+func GrowthFactor(factor float64) GrowthPolicy {
+	if factor <= 1 {
+		panic("arraylist: growth factor should be greater than 1")
+	}
+	return func(cap, need int) int {
+		return int(float64(cap)*factor) + 1
+	}
+}
+
+// GrowthStep returns a policy adding 'step' elements of capacity at a time.
+func GrowthStep(step int) GrowthPolicy {
+	if step <= 0 {
+		panic("arraylist: growth step should be positive")
+	}
+	return func(cap, need int) int {
+		return cap + step
+	}
+}
+
+type config struct {
+	capacity int
+	grow     GrowthPolicy
+	shrink   float64
+}
+
+// Option configures capacity management of a list created by NewWith.
+type Option func(*config)
+
+// sets the initial capacity, which is also the floor of automatic shrinking
+func WithCapacity(n int) Option {
+	return func(c *config) { c.capacity = max(n, 0) }
+}
+
+// sets the growth policy, without one the list grows like the builtin append
+func WithGrowthPolicy(p GrowthPolicy) Option {
+	return func(c *config) { c.grow = p }
+}
+
+// shortcut of WithGrowthPolicy(GrowthFactor(factor))
+func WithGrowthFactor(factor float64) Option {
+	return WithGrowthPolicy(GrowthFactor(factor))
+}
+
+// enables automatic shrinking once occupancy, length divided by capacity,
+// drops below 'ratio' after removals, the backing array is then reallocated
+// at twice the length, never below the initial capacity
+func WithShrinkThreshold(ratio float64) Option {
+	if ratio < 0 || ratio >= 0.5 {
+		panic("arraylist: shrink threshold should be in range [0, 0.5)")
+	}
+	return func(c *config) { c.shrink = ratio }
+}
+
+func NewWith[T any](opts ...Option) *ArrayList[T] {
+	c := config{capacity: DEFAULT_CAP}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &ArrayList[T]{
+		elements: make([]T, 0, c.capacity),
+		minCap:   c.capacity,
+		grow:     c.grow,
+		shrink:   c.shrink,
+	}
+}
+
+// ensures capacity of at least 'n' elements in total without applying the growth policy
+func (l *ArrayList[T]) Reserve(n int) {
+	if n > l.Cap() {
+		l.realloc(n)
+	}
+}
+
+// ensures room for 'n' more elements following the growth policy
+func (l *ArrayList[T]) ensure(n int) {
+	need := l.Len() + n
+	if l.grow == nil || need <= l.Cap() {
+		return
+	}
+	l.realloc(max(l.grow(l.Cap(), need), need))
+}
+
+// shrinks the backing array once occupancy drops below the threshold
+func (l *ArrayList[T]) release() {
+	if l.shrink == 0 || l.Cap() <= l.minCap || float64(l.Len()) >= float64(l.Cap())*l.shrink {
+		return
+	}
+	l.realloc(max(2*l.Len(), l.minCap))
+}
+
+func (l *ArrayList[T]) realloc(capacity int) {
+	elements := make([]T, l.Len(), capacity)
+	copy(elements, l.elements)
+	l.elements = elements
+}
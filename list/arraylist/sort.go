@@ -0,0 +1,36 @@
+package arraylist
+
+import (
+	"sort"
+
+	"go-data-structure/list"
+)
+
+// sorts elements in place, not guaranteed to be stable
+func (l *ArrayList[T]) Sort(less list.Less[T]) {
+	sort.Slice(l.elements, func(i, j int) bool { return less(l.elements[i], l.elements[j]) })
+}
+
+// sorts elements in place keeping the original order of equal elements
+func (l *ArrayList[T]) SortStable(less list.Less[T]) {
+	sort.SliceStable(l.elements, func(i, j int) bool { return less(l.elements[i], l.elements[j]) })
+}
+
+func (l *ArrayList[T]) IsSorted(less list.Less[T]) bool {
+	return sort.SliceIsSorted(l.elements, func(i, j int) bool { return less(l.elements[i], l.elements[j]) })
+}
+
+// searches a list sorted by 'compare', returns the index of the first element
+// equals to 'v' and true, orelse the index where 'v' would be inserted and false
+func (l *ArrayList[T]) BinarySearch(v T, compare list.Comparator[T]) (int, bool) {
+	idx := sort.Search(l.Len(), func(i int) bool { return compare(l.elements[i], v) >= 0 })
+	return idx, idx < l.Len() && compare(l.elements[idx], v) == 0
+}
+
+// inserts 'v' into a list sorted by 'compare' after any equal elements,
+// returns the index where 'v' is inserted
+func (l *ArrayList[T]) SortedInsert(v T, compare list.Comparator[T]) int {
+	idx := sort.Search(l.Len(), func(i int) bool { return compare(l.elements[i], v) > 0 })
+	l.Insert(idx, v)
+	return idx
+}
@@ -0,0 +1,97 @@
+package arraylist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrIndexOutOfRange is matched by every *IndexError with errors.Is.
+var ErrIndexOutOfRange = errors.New("arraylist: index out of range")
+
+// IndexError reports an index out of range of a list of length Len.
+// Inclusive reports whether Len itself is a valid index, as for Insert.
+type IndexError struct {
+	Index     int
+	Len       int
+	Inclusive bool
+}
+
+func (e *IndexError) Error() string {
+	bound := ")"
+	if e.Inclusive {
+		bound = "]"
+	}
+	return fmt.Sprintf("arraylist: index %d out of range [0:%d%s", e.Index, e.Len, bound)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// Strict is a view of an ArrayList whose index operations report
+// out of range indexes as *IndexError instead of ignoring them.
+type Strict[T any] struct {
+	l *ArrayList[T]
+}
+
+// returns the strict view sharing elements with the list
+func (l *ArrayList[T]) Strict() *Strict[T] {
+	return &Strict[T]{l: l}
+}
+
+// returns the lenient list under the view
+func (s *Strict[T]) List() *ArrayList[T] {
+	return s.l
+}
+
+func (s *Strict[T]) Len() int {
+	return s.l.Len()
+}
+
+func (s *Strict[T]) check(idx int, inclusive bool) error {
+	length := s.l.Len()
+	if inclusive {
+		length++
+	}
+	if idx < 0 || idx >= length {
+		return &IndexError{Index: idx, Len: s.l.Len(), Inclusive: inclusive}
+	}
+	return nil
+}
+
+func (s *Strict[T]) Get(idx int) (T, error) {
+	if err := s.check(idx, false); err != nil {
+		var e T
+		return e, err
+	}
+	return s.l.elements[idx], nil
+}
+
+// returns the replaced element
+func (s *Strict[T]) Set(idx int, e T) (T, error) {
+	if err := s.check(idx, false); err != nil {
+		var el T
+		return el, err
+	}
+	el, _ := s.l.Set(idx, e)
+	return el, nil
+}
+
+// inserts elements before 'idx', 'idx' equals to the length appends them
+func (s *Strict[T]) Insert(idx int, e ...T) error {
+	if err := s.check(idx, true); err != nil {
+		return err
+	}
+	s.l.Insert(idx, e...)
+	return nil
+}
+
+// returns the removed element
+func (s *Strict[T]) Remove(idx int) (T, error) {
+	if err := s.check(idx, false); err != nil {
+		var e T
+		return e, err
+	}
+	e, _ := s.l.RemoveAt(idx)
+	return e, nil
+}
@@ -0,0 +1,94 @@
+package arraylist
+
+import "errors"
+
+// ErrConcurrentModification is returned by a SubList accessed after
+// a structural modification of its parent list.
+var ErrConcurrentModification = errors.New("arraylist: concurrent modification of parent list")
+
+// SubList is a view of range [from, to) of an ArrayList without copying,
+// reads and writes go through to the parent's backing array.
+//
+// The view is valid until the parent is structurally modified, i.e. its
+// length changes, afterwards every access reports ErrConcurrentModification.
+type SubList[T any] struct {
+	parent   *ArrayList[T]
+	from, to int
+	mod      int
+}
+
+// returns the view of range [from, to), *IndexError if the range is invalid
+func (l *ArrayList[T]) SubList(from, to int) (*SubList[T], error) {
+	if from < 0 || from > l.Len() {
+		return nil, &IndexError{Index: from, Len: l.Len(), Inclusive: true}
+	}
+	if to < from || to > l.Len() {
+		return nil, &IndexError{Index: to, Len: l.Len(), Inclusive: true}
+	}
+	return &SubList[T]{parent: l, from: from, to: to, mod: l.mod}, nil
+}
+
+func (s *SubList[T]) check(idx int) error {
+	if s.mod != s.parent.mod {
+		return ErrConcurrentModification
+	}
+	if idx < 0 || idx >= s.Len() {
+		return &IndexError{Index: idx, Len: s.Len()}
+	}
+	return nil
+}
+
+func (s *SubList[T]) Len() int {
+	return s.to - s.from
+}
+
+func (s *SubList[T]) Get(idx int) (T, error) {
+	if err := s.check(idx); err != nil {
+		var e T
+		return e, err
+	}
+	return s.parent.elements[s.from+idx], nil
+}
+
+// returns the replaced element
+func (s *SubList[T]) Set(idx int, e T) (T, error) {
+	if err := s.check(idx); err != nil {
+		var el T
+		return el, err
+	}
+	el := s.parent.elements[s.from+idx]
+	s.parent.elements[s.from+idx] = e
+	return el, nil
+}
+
+// calls 'fn' for each element until it returns false, stops with
+// ErrConcurrentModification if 'fn' modifies the parent structurally
+func (s *SubList[T]) Range(fn func(e T) bool) error {
+	for idx := 0; idx < s.Len(); idx++ {
+		e, err := s.Get(idx)
+		if err != nil {
+			return err
+		}
+		if !fn(e) {
+			return nil
+		}
+	}
+	if s.mod != s.parent.mod {
+		return ErrConcurrentModification
+	}
+	return nil
+}
+
+// returns the view of range [from, to) of the sub-list
+func (s *SubList[T]) SubList(from, to int) (*SubList[T], error) {
+	if s.mod != s.parent.mod {
+		return nil, ErrConcurrentModification
+	}
+	if from < 0 || from > s.Len() {
+		return nil, &IndexError{Index: from, Len: s.Len(), Inclusive: true}
+	}
+	if to < from || to > s.Len() {
+		return nil, &IndexError{Index: to, Len: s.Len(), Inclusive: true}
+	}
+	return &SubList[T]{parent: s.parent, from: s.from + from, to: s.from + to, mod: s.mod}, nil
+}
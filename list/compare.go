@@ -0,0 +1,112 @@
+package list
+
+import "go-data-structure/constraints"
+
+// Ordered returns the natural order comparator of an ordered type.
+func Ordered[T constraints.Ordered]() Comparator[T] {
+	return func(i, j T) int {
+		if i < j {
+			return -1
+		}
+		if i > j {
+			return 1
+		}
+		return 0
+	}
+}
+
+// By returns a comparator ordering elements by the natural order of the extracted key.
+func By[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return ByFunc(key, Ordered[K]())
+}
+
+// ByFunc returns a comparator ordering elements by the extracted key compared with 'compare'.
+func ByFunc[T, K any](key func(T) K, compare Comparator[K]) Comparator[T] {
+	return func(i, j T) int {
+		return compare(key(i), key(j))
+	}
+}
+
+// NilsFirst returns a comparator of pointers ordering nil before any other
+// pointer and comparing non-nil pointers by the pointed elements.
+func NilsFirst[T any](compare Comparator[T]) Comparator[*T] {
+	return nils(compare, -1)
+}
+
+// NilsLast returns a comparator of pointers ordering nil after any other
+// pointer and comparing non-nil pointers by the pointed elements.
+func NilsLast[T any](compare Comparator[T]) Comparator[*T] {
+	return nils(compare, 1)
+}
+
+func nils[T any](compare Comparator[T], order int) Comparator[*T] {
+	return func(i, j *T) int {
+		switch {
+		case i == nil && j == nil:
+			return 0
+		case i == nil:
+			return order
+		case j == nil:
+			return -order
+		}
+		return compare(*i, *j)
+	}
+}
+
+// Reverse returns the comparator imposing the reverse order.
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(i, j T) int {
+		return c(j, i)
+	}
+}
+
+// ThenComparing returns a comparator breaking ties of 'c' with 'next'.
+func (c Comparator[T]) ThenComparing(next Comparator[T]) Comparator[T] {
+	return func(i, j T) int {
+		if r := c(i, j); r != 0 {
+			return r
+		}
+		return next(i, j)
+	}
+}
+
+// Less converts the comparator to a Less.
+func (c Comparator[T]) Less() Less[T] {
+	return func(i, j T) bool {
+		return c(i, j) < 0
+	}
+}
+
+// Equaler converts the comparator to an Equaler.
+func (c Comparator[T]) Equaler() Equaler[T] {
+	return func(i, j T) bool {
+		return c(i, j) == 0
+	}
+}
+
+// Reverse returns the Less imposing the reverse order.
+func (l Less[T]) Reverse() Less[T] {
+	return func(i, j T) bool {
+		return l(j, i)
+	}
+}
+
+// Comparator converts the Less to a Comparator.
+func (l Less[T]) Comparator() Comparator[T] {
+	return func(i, j T) int {
+		if l(i, j) {
+			return -1
+		}
+		if l(j, i) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// Equaler converts the Less to an Equaler, elements are equal if neither sorts before the other.
+func (l Less[T]) Equaler() Equaler[T] {
+	return func(i, j T) bool {
+		return !l(i, j) && !l(j, i)
+	}
+}
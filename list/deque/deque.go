@@ -0,0 +1,199 @@
+package deque
+
+import "go-data-structure/list"
+
+const _MIN_CAP = 8
+
+// Overflow decides what a bounded Deque does when pushing into a full deque.
+type Overflow int
+
+const (
+	// Reject drops the pushed element.
+	Reject Overflow = iota
+	// Overwrite drops the element at the opposite end to make room.
+	Overwrite
+)
+
+var _ list.Sequence[int] = (*Deque[int])(nil)
+
+// Deque is a double-ended queue over a ring buffer, pushing and popping
+// at both ends are amortized O(1) and indexing is O(1).
+//
+// An unbounded Deque doubles its buffer when full and halves it when
+// occupancy drops to a quarter, a bounded one never reallocates.
+// The zero value is an empty unbounded deque ready to use.
+type Deque[T any] struct {
+	buf      []T
+	head     int
+	length   int
+	bounded  bool
+	overflow Overflow
+}
+
+func New[T any](e ...T) *Deque[T] {
+	d := &Deque[T]{buf: make([]T, max(_MIN_CAP, len(e)))}
+	for _, v := range e {
+		d.PushBack(v)
+	}
+	return d
+}
+
+// returns a deque holding at most 'capacity' elements
+func NewBounded[T any](capacity int, overflow Overflow) *Deque[T] {
+	if capacity < 1 {
+		panic("deque: invalid capacity, should be at least 1")
+	}
+	return &Deque[T]{buf: make([]T, capacity), bounded: true, overflow: overflow}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+func (d *Deque[T]) Cap() int {
+	return len(d.buf)
+}
+
+func (d *Deque[T]) IsFull() bool {
+	return d.bounded && d.length == len(d.buf)
+}
+
+// physical position of the logical index
+func (d *Deque[T]) at(idx int) int {
+	return (d.head + idx) % len(d.buf)
+}
+
+// reports false if a full bounded deque rejects 'v'
+func (d *Deque[T]) PushBack(v T) bool {
+	if !d.room(false) {
+		return false
+	}
+	d.buf[d.at(d.length)] = v
+	d.length++
+	return true
+}
+
+// reports false if a full bounded deque rejects 'v'
+func (d *Deque[T]) PushFront(v T) bool {
+	if !d.room(true) {
+		return false
+	}
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.length++
+	return true
+}
+
+// makes room for one more element, growing or overwriting the opposite end
+func (d *Deque[T]) room(front bool) bool {
+	if d.length < len(d.buf) {
+		return true
+	}
+	if !d.bounded {
+		// a zero Deque allocates its buffer on the first push
+		d.resize(max(2*len(d.buf), _MIN_CAP))
+		return true
+	}
+	if d.overflow == Reject {
+		return false
+	}
+	if front {
+		d.PopBack()
+	} else {
+		d.PopFront()
+	}
+	return true
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var v T
+	if d.length == 0 {
+		return v, false
+	}
+	v, d.buf[d.head] = d.buf[d.head], v
+	d.head = (d.head + 1) % len(d.buf)
+	d.length--
+	d.release()
+	return v, true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var v T
+	if d.length == 0 {
+		return v, false
+	}
+	tail := d.at(d.length - 1)
+	v, d.buf[tail] = d.buf[tail], v
+	d.length--
+	d.release()
+	return v, true
+}
+
+func (d *Deque[T]) Front() (T, bool) {
+	return d.Get(0)
+}
+
+func (d *Deque[T]) Back() (T, bool) {
+	return d.Get(d.length - 1)
+}
+
+// returns empty of 'T' if out of range
+func (d *Deque[T]) Get(idx int) (T, bool) {
+	var v T
+	if idx < 0 || idx >= d.length {
+		return v, false
+	}
+	return d.buf[d.at(idx)], true
+}
+
+// returns the replaced element, empty of 'T' if out of range
+func (d *Deque[T]) Set(idx int, v T) (T, bool) {
+	var e T
+	if idx < 0 || idx >= d.length {
+		return e, false
+	}
+	p := d.at(idx)
+	e, d.buf[p] = d.buf[p], v
+	return e, true
+}
+
+// drops the buffer of an unbounded deque back to the minimum capacity
+func (d *Deque[T]) Clear() {
+	if d.bounded || len(d.buf) <= _MIN_CAP {
+		clear(d.buf)
+	} else {
+		d.buf = make([]T, _MIN_CAP)
+	}
+	d.head = 0
+	d.length = 0
+}
+
+// calls fn for each element from front to back until fn returns false
+func (d *Deque[T]) Range(fn func(e T) bool) {
+	for idx := 0; idx < d.length; idx++ {
+		if !fn(d.buf[d.at(idx)]) {
+			return
+		}
+	}
+}
+
+// shrinks an unbounded buffer once a quarter full
+func (d *Deque[T]) release() {
+	if d.bounded || len(d.buf) <= _MIN_CAP || d.length > len(d.buf)/4 {
+		return
+	}
+	d.resize(max(len(d.buf)/2, _MIN_CAP))
+}
+
+// moves elements to a new buffer of 'capacity' starting at position 0
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if d.head+d.length <= len(d.buf) {
+		copy(buf, d.buf[d.head:d.head+d.length])
+	} else {
+		n := copy(buf, d.buf[d.head:])
+		copy(buf[n:], d.buf[:d.length-n])
+	}
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,178 @@
+package deque
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func Output(d *Deque[int]) {
+	es := []int{}
+	d.Range(func(e int) bool {
+		es = append(es, e)
+		return true
+	})
+	fmt.Println(es)
+}
+
+func ExampleNew() {
+	d := New(1, 2, 3)
+	Output(d)
+	fmt.Println(d.Len())
+	// Output:
+	// [1 2 3]
+	// 3
+}
+
+func ExampleDeque_PushFront() {
+	d := New[int]()
+	for i := 0; i < 5; i++ {
+		d.PushFront(i)
+	}
+	Output(d)
+	// Output:
+	// [4 3 2 1 0]
+}
+
+func ExampleDeque_PopFront() {
+	d := New(1, 2, 3)
+	fmt.Println(d.PopFront())
+	fmt.Println(d.PopBack())
+	fmt.Println(d.PopBack())
+	fmt.Println(d.PopFront())
+	// Output:
+	// 1 true
+	// 3 true
+	// 2 true
+	// 0 false
+}
+
+func ExampleDeque_Get() {
+	d := New(1, 2, 3)
+	d.PushFront(0)
+	fmt.Println(d.Get(0))
+	fmt.Println(d.Get(3))
+	fmt.Println(d.Get(4))
+	d.Set(1, 10)
+	Output(d)
+	// Output:
+	// 0 true
+	// 3 true
+	// 0 false
+	// [0 10 2 3]
+}
+
+func ExampleNewBounded() {
+	reject := NewBounded[int](3, Reject)
+	overwrite := NewBounded[int](3, Overwrite)
+	for i := 0; i < 5; i++ {
+		reject.PushBack(i)
+		overwrite.PushBack(i)
+	}
+	Output(reject)
+	Output(overwrite)
+	overwrite.PushFront(9)
+	Output(overwrite)
+	fmt.Println(reject.PushBack(5), reject.IsFull())
+	// Output:
+	// [0 1 2]
+	// [2 3 4]
+	// [9 2 3]
+	// false true
+}
+
+func TestDeque(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := New[int]()
+	var ref []int
+	for i := 0; i < 100000; i++ {
+		switch r.Intn(5) {
+		case 0, 1:
+			d.PushBack(i)
+			ref = append(ref, i)
+		case 2:
+			d.PushFront(i)
+			ref = append([]int{i}, ref...)
+		case 3:
+			v, ok := d.PopFront()
+			if ok != (len(ref) > 0) || ok && v != ref[0] {
+				t.Fatalf("op %d: PopFront() = %d, %v", i, v, ok)
+			}
+			if ok {
+				ref = ref[1:]
+			}
+		case 4:
+			v, ok := d.PopBack()
+			if ok != (len(ref) > 0) || ok && v != ref[len(ref)-1] {
+				t.Fatalf("op %d: PopBack() = %d, %v", i, v, ok)
+			}
+			if ok {
+				ref = ref[:len(ref)-1]
+			}
+		}
+		if d.Len() != len(ref) {
+			t.Fatalf("op %d: Len() = %d, want %d", i, d.Len(), len(ref))
+		}
+		if len(ref) > 0 {
+			idx := r.Intn(len(ref))
+			if v, _ := d.Get(idx); v != ref[idx] {
+				t.Fatalf("op %d: Get(%d) = %d, want %d", i, idx, v, ref[idx])
+			}
+		}
+	}
+	var es []int
+	d.Range(func(e int) bool {
+		es = append(es, e)
+		return true
+	})
+	if !slices.Equal(es, ref) {
+		t.Fatalf("Range visits %v, want %v", es, ref)
+	}
+	for d.Len() > 0 {
+		d.PopBack()
+	}
+	if d.Cap() != _MIN_CAP {
+		t.Fatalf("Cap() = %d after draining, want %d", d.Cap(), _MIN_CAP)
+	}
+	for i := 0; i < 1000; i++ {
+		d.PushBack(i)
+	}
+	d.Clear()
+	if d.Len() != 0 || d.Cap() != _MIN_CAP {
+		t.Fatalf("Cap() = %d after Clear, want %d", d.Cap(), _MIN_CAP)
+	}
+	b := NewBounded[int](100, Reject)
+	b.PushBack(1)
+	b.Clear()
+	if b.Len() != 0 || b.Cap() != 100 {
+		t.Fatalf("bounded Cap() = %d after Clear, want 100", b.Cap())
+	}
+}
+
+func TestDequeZeroValue(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok || d.Len() != 0 {
+		t.Fatalf("zero deque is not empty")
+	}
+	d.PushFront(1)
+	d.PushBack(2)
+	if v, _ := d.Get(1); d.Len() != 2 || v != 2 {
+		t.Fatalf("Get(1) = %d with Len() = %d, want 2 of 2", v, d.Len())
+	}
+	var b Deque[int]
+	b.PushBack(1)
+	if v, ok := b.Front(); !ok || v != 1 || b.Cap() != _MIN_CAP {
+		t.Fatalf("Front() = %d, %v with Cap() = %d", v, ok, b.Cap())
+	}
+}
+
+func BenchmarkQueue(b *testing.B) {
+	d := New[int]()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		if d.Len() > 1000 {
+			d.PopFront()
+		}
+	}
+}
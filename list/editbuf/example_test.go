@@ -0,0 +1,204 @@
+package editbuf
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list"
+)
+
+func Output(s list.Sequence[byte]) {
+	var bs []byte
+	s.Range(func(e byte) bool {
+		bs = append(bs, e)
+		return true
+	})
+	fmt.Println(string(bs))
+}
+
+func ExampleGapBuffer() {
+	g := NewGapBuffer([]byte("hello world")...)
+	g.Seek(5)
+	g.Write([]byte(",")...)
+	g.Seek(g.Len())
+	g.Write('!')
+	Output(g)
+	g.Backspace()
+	g.Seek(0)
+	g.Delete()
+	g.Write('H')
+	Output(g)
+	fmt.Println(g.Cursor())
+	// Output:
+	// hello, world!
+	// Hello, world
+	// 1
+}
+
+func ExampleGapBuffer_Undo() {
+	g := NewGapBuffer[byte]()
+	g.Write([]byte("abc")...)
+	g.Backspace()
+	g.Insert(0, 'x')
+	Output(g)
+	g.Undo()
+	Output(g)
+	g.Undo()
+	Output(g)
+	g.Redo()
+	Output(g)
+	// Output:
+	// xab
+	// ab
+	// abc
+	// ab
+}
+
+func ExamplePieceTable_Set() {
+	p := NewPieceTable([]byte("hello")...)
+	p.Set(0, 'j')
+	p.Set(4, 'y')
+	Output(p)
+	p.Undo()
+	Output(p)
+	fmt.Println(p.Set(5, '!'))
+	// Output:
+	// jelly
+	// jello
+	// 0 false
+}
+
+func ExamplePieceTable() {
+	p := NewPieceTable([]byte("hello world")...)
+	p.Insert(5, ',')
+	p.Insert(p.Len(), '!')
+	p.RemoveRange(0, 1)
+	p.Insert(0, 'H')
+	Output(p)
+	fmt.Println(p.Pieces())
+	p.Undo()
+	Output(p)
+	p.Undo()
+	Output(p)
+	// Output:
+	// Hello, world!
+	// 5
+	// ello, world!
+	// hello, world!
+}
+
+type editable interface {
+	list.Sequence[int]
+	Get(idx int) (int, bool)
+	Set(idx int, e int) (int, bool)
+	Insert(idx int, e ...int)
+	Remove(idx int)
+	RemoveRange(from, to int)
+	Undo() bool
+	Redo() bool
+}
+
+func contents(s list.Sequence[int]) []int {
+	es := []int{}
+	s.Range(func(e int) bool {
+		es = append(es, e)
+		return true
+	})
+	return es
+}
+
+func testEditable(t *testing.T, b editable) {
+	r := rand.New(rand.NewSource(1))
+	ref := contents(b)
+	var versions [][]int
+	for i := 0; i < 3000; i++ {
+		versions = append(versions, slices.Clone(ref))
+		idx := r.Intn(len(ref) + 1)
+		switch r.Intn(5) {
+		case 0, 1:
+			es := []int{i, -i}[:1+r.Intn(2)]
+			b.Insert(idx, es...)
+			ref = slices.Insert(ref, idx, es...)
+		case 2:
+			if idx == len(ref) {
+				versions = versions[:len(versions)-1]
+				continue
+			}
+			b.Remove(idx)
+			ref = slices.Delete(ref, idx, idx+1)
+		case 3:
+			to := min(idx+r.Intn(5)+1, len(ref))
+			if idx == to {
+				versions = versions[:len(versions)-1]
+				continue
+			}
+			b.RemoveRange(idx, to)
+			ref = slices.Delete(ref, idx, to)
+		case 4:
+			old, ok := b.Set(idx, i)
+			if ok != (idx < len(ref)) || ok && old != ref[idx] {
+				t.Fatalf("op %d: Set(%d) = %d, %v", i, idx, old, ok)
+			}
+			if !ok {
+				versions = versions[:len(versions)-1]
+				continue
+			}
+			ref[idx] = i
+		}
+		if b.Len() != len(ref) {
+			t.Fatalf("op %d: Len() = %d, want %d", i, b.Len(), len(ref))
+		}
+		if len(ref) > 0 {
+			idx := r.Intn(len(ref))
+			if e, ok := b.Get(idx); !ok || e != ref[idx] {
+				t.Fatalf("op %d: Get(%d) = %d, %v, want %d", i, idx, e, ok, ref[idx])
+			}
+		}
+	}
+	if got := contents(b); !slices.Equal(got, ref) {
+		t.Fatalf("contents %v, want %v", got, ref)
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !b.Undo() {
+			t.Fatalf("Undo() to version %d reports nothing to undo", i)
+		}
+		if got := contents(b); !slices.Equal(got, versions[i]) {
+			t.Fatalf("Undo() to version %d = %v, want %v", i, got, versions[i])
+		}
+	}
+	if b.Undo() {
+		t.Fatalf("Undo() of the first version reports undone")
+	}
+	for b.Redo() {
+	}
+	if got := contents(b); !slices.Equal(got, ref) {
+		t.Fatalf("contents after redo %v, want %v", got, ref)
+	}
+}
+
+func TestGapBuffer(t *testing.T) {
+	testEditable(t, NewGapBuffer[int]())
+}
+
+func TestPieceTable(t *testing.T) {
+	testEditable(t, NewPieceTable(1, 2, 3))
+}
+
+func BenchmarkLocalInsert(b *testing.B) {
+	doc := make([]byte, 1<<20)
+	b.Run("GapBuffer", func(b *testing.B) {
+		g := NewGapBuffer(doc...)
+		g.Seek(len(doc) / 2)
+		for i := 0; i < b.N; i++ {
+			g.Write('x')
+		}
+	})
+	b.Run("PieceTable", func(b *testing.B) {
+		p := NewPieceTable(doc...)
+		for i := 0; i < b.N; i++ {
+			p.Insert(len(doc)/2+i, 'x')
+		}
+	})
+}
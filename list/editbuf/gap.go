@@ -0,0 +1,187 @@
+package editbuf
+
+import "go-data-structure/list"
+
+const _MIN_GAP = 32
+
+var _ list.Sequence[int] = (*GapBuffer[int])(nil)
+
+// GapBuffer is a sequence keeping a gap of free slots at the cursor, so
+// inserting and removing at the cursor are O(1) and moving the cursor
+// costs the distance moved.
+//
+// Every Insert and Remove moves the cursor to the edited position, which
+// makes consecutive edits around the same place cheap.
+type GapBuffer[T any] struct {
+	buf []T
+	// gap occupies buf[start:end], start is the cursor
+	start, end int
+	history    history[T]
+}
+
+func NewGapBuffer[T any](e ...T) *GapBuffer[T] {
+	g := &GapBuffer[T]{}
+	g.insert(0, e)
+	return g
+}
+
+// Len returns the number of elements.
+func (g *GapBuffer[T]) Len() int {
+	return len(g.buf) - (g.end - g.start)
+}
+
+// Cursor returns the position where Write inserts.
+func (g *GapBuffer[T]) Cursor() int {
+	return g.start
+}
+
+// Seek moves the cursor to idx, clamped to [0, Len].
+func (g *GapBuffer[T]) Seek(idx int) {
+	g.moveGap(min(max(idx, 0), g.Len()))
+}
+
+// Get returns the element at idx, false if out of range.
+func (g *GapBuffer[T]) Get(idx int) (T, bool) {
+	var e T
+	if idx < 0 || idx >= g.Len() {
+		return e, false
+	}
+	if idx >= g.start {
+		idx += g.end - g.start
+	}
+	return g.buf[idx], true
+}
+
+// Set replaces the element at idx and returns the replaced one, false if out of range.
+// The cursor stays in place.
+func (g *GapBuffer[T]) Set(idx int, e T) (T, bool) {
+	old, ok := g.Get(idx)
+	if !ok {
+		return old, false
+	}
+	g.history.record(op[T]{idx: idx, removed: []T{old}, inserted: []T{e}})
+	if idx >= g.start {
+		idx += g.end - g.start
+	}
+	g.buf[idx] = e
+	return old, true
+}
+
+// Insert inserts elements before idx, appends them if idx is out of range.
+// The cursor moves after the inserted elements.
+func (g *GapBuffer[T]) Insert(idx int, e ...T) {
+	if idx < 0 || idx > g.Len() {
+		idx = g.Len()
+	}
+	es := append([]T(nil), e...)
+	g.history.record(op[T]{idx: idx, inserted: es})
+	g.insert(idx, es)
+}
+
+// Remove removes the element at idx, ignored if out of range.
+func (g *GapBuffer[T]) Remove(idx int) {
+	if idx < 0 || idx >= g.Len() {
+		return
+	}
+	g.RemoveRange(idx, idx+1)
+}
+
+// RemoveRange removes elements in range [from, to), the range is clamped.
+// The cursor moves to from.
+func (g *GapBuffer[T]) RemoveRange(from, to int) {
+	from, to = clamp(from, to, g.Len())
+	g.history.record(op[T]{idx: from, removed: g.remove(from, to)})
+}
+
+// Write inserts elements at the cursor.
+func (g *GapBuffer[T]) Write(e ...T) {
+	g.Insert(g.start, e...)
+}
+
+// Backspace removes the element before the cursor, false if the cursor is at the front.
+func (g *GapBuffer[T]) Backspace() bool {
+	if g.start == 0 {
+		return false
+	}
+	g.RemoveRange(g.start-1, g.start)
+	return true
+}
+
+// Delete removes the element at the cursor, false if the cursor is at the back.
+func (g *GapBuffer[T]) Delete() bool {
+	if g.start == g.Len() {
+		return false
+	}
+	g.RemoveRange(g.start, g.start+1)
+	return true
+}
+
+// Clear removes all elements, it can be undone.
+func (g *GapBuffer[T]) Clear() {
+	g.RemoveRange(0, g.Len())
+}
+
+// Range calls fn for each element in order until fn returns false.
+func (g *GapBuffer[T]) Range(fn func(e T) bool) {
+	for _, e := range g.buf[:g.start] {
+		if !fn(e) {
+			return
+		}
+	}
+	for _, e := range g.buf[g.end:] {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// Undo reverts the latest edit, false if there is nothing to undo.
+func (g *GapBuffer[T]) Undo() bool {
+	return g.history.undo(g)
+}
+
+// Redo reapplies the latest undone edit, false if there is nothing to redo.
+func (g *GapBuffer[T]) Redo() bool {
+	return g.history.redo(g)
+}
+
+func (g *GapBuffer[T]) insert(idx int, es []T) {
+	g.moveGap(idx)
+	g.grow(len(es))
+	g.start += copy(g.buf[g.start:], es)
+}
+
+func (g *GapBuffer[T]) remove(from, to int) []T {
+	g.moveGap(from)
+	removed := append([]T(nil), g.buf[g.end:g.end+to-from]...)
+	clear(g.buf[g.end : g.end+to-from])
+	g.end += to - from
+	return removed
+}
+
+// moveGap shifts the elements between the cursor and idx across the gap.
+func (g *GapBuffer[T]) moveGap(idx int) {
+	if idx < g.start {
+		n := g.start - idx
+		copy(g.buf[g.end-n:g.end], g.buf[idx:g.start])
+		clear(g.buf[idx:min(g.start, g.end-n)])
+		g.start, g.end = idx, g.end-n
+	} else if idx > g.start {
+		n := idx - g.start
+		copy(g.buf[g.start:], g.buf[g.end:g.end+n])
+		clear(g.buf[max(g.end, idx):g.end+n])
+		g.start, g.end = idx, g.end+n
+	}
+}
+
+// grow widens the gap to at least n slots.
+func (g *GapBuffer[T]) grow(n int) {
+	if g.end-g.start >= n {
+		return
+	}
+	buf := make([]T, max(2*len(g.buf), g.Len()+n+_MIN_GAP))
+	copy(buf, g.buf[:g.start])
+	end := len(buf) - (len(g.buf) - g.end)
+	copy(buf[end:], g.buf[g.end:])
+	g.buf, g.end = buf, end
+}
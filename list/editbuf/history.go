@@ -0,0 +1,74 @@
+// Package editbuf implements sequences tuned for editor-style workloads,
+// where most edits happen close to the previous one, with undo and redo
+// of recorded operations.
+package editbuf
+
+// editor is implemented by buffers whose raw edits can be replayed.
+type editor[T any] interface {
+	insert(idx int, es []T)
+	remove(from, to int) []T
+}
+
+// op is a recorded edit, the elements removed from idx replaced by the
+// inserted ones.
+type op[T any] struct {
+	idx      int
+	removed  []T
+	inserted []T
+}
+
+// history records edits to undo and redo them.
+type history[T any] struct {
+	undos, redos []op[T]
+}
+
+func (h *history[T]) record(o op[T]) {
+	if len(o.removed) == 0 && len(o.inserted) == 0 {
+		return
+	}
+	h.undos = append(h.undos, o)
+	clear(h.redos)
+	h.redos = h.redos[:0]
+}
+
+func (h *history[T]) undo(e editor[T]) bool {
+	if len(h.undos) == 0 {
+		return false
+	}
+	o := h.undos[len(h.undos)-1]
+	h.undos = h.undos[:len(h.undos)-1]
+	apply(e, o, true)
+	h.redos = append(h.redos, o)
+	return true
+}
+
+func (h *history[T]) redo(e editor[T]) bool {
+	if len(h.redos) == 0 {
+		return false
+	}
+	o := h.redos[len(h.redos)-1]
+	h.redos = h.redos[:len(h.redos)-1]
+	apply(e, o, false)
+	h.undos = append(h.undos, o)
+	return true
+}
+
+// apply replays the edit or its inverse.
+func apply[T any](e editor[T], o op[T], inverse bool) {
+	removed, inserted := o.removed, o.inserted
+	if inverse {
+		removed, inserted = inserted, removed
+	}
+	if len(removed) > 0 {
+		e.remove(o.idx, o.idx+len(removed))
+	}
+	if len(inserted) > 0 {
+		e.insert(o.idx, inserted)
+	}
+}
+
+// clamp limits the range [from, to) to a sequence of length n.
+func clamp(from, to, n int) (int, int) {
+	from, to = max(from, 0), min(to, n)
+	return from, max(from, to)
+}
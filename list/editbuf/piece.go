@@ -0,0 +1,192 @@
+package editbuf
+
+import "go-data-structure/list"
+
+var _ list.Sequence[int] = (*PieceTable[int])(nil)
+
+// piece is a span of the original or the add buffer.
+type piece struct {
+	add           bool
+	start, length int
+}
+
+// PieceTable is a sequence described by pieces of two buffers, the
+// read-only original elements and an append-only buffer of inserted
+// elements. Edits only split and rewrite pieces, so their cost depends
+// on the number of edits rather than on the size of the document.
+type PieceTable[T any] struct {
+	original, added []T
+	pieces          []piece
+	length          int
+	history         history[T]
+}
+
+// NewPieceTable returns a table over the original elements, which must
+// not be modified afterwards.
+func NewPieceTable[T any](original ...T) *PieceTable[T] {
+	p := &PieceTable[T]{original: original, length: len(original)}
+	if len(original) > 0 {
+		p.pieces = []piece{{start: 0, length: len(original)}}
+	}
+	return p
+}
+
+// Len returns the number of elements.
+func (p *PieceTable[T]) Len() int {
+	return p.length
+}
+
+// Pieces returns the number of pieces describing the sequence.
+func (p *PieceTable[T]) Pieces() int {
+	return len(p.pieces)
+}
+
+func (p *PieceTable[T]) buffer(pc piece) []T {
+	if pc.add {
+		return p.added[pc.start : pc.start+pc.length]
+	}
+	return p.original[pc.start : pc.start+pc.length]
+}
+
+// locate returns the piece holding idx and the offset of idx within it.
+func (p *PieceTable[T]) locate(idx int) (int, int) {
+	for i, pc := range p.pieces {
+		if idx < pc.length {
+			return i, idx
+		}
+		idx -= pc.length
+	}
+	return len(p.pieces), 0
+}
+
+// Get returns the element at idx, false if out of range.
+func (p *PieceTable[T]) Get(idx int) (T, bool) {
+	var e T
+	if idx < 0 || idx >= p.length {
+		return e, false
+	}
+	i, off := p.locate(idx)
+	return p.buffer(p.pieces[i])[off], true
+}
+
+// Set replaces the element at idx and returns the replaced one, false if out of range.
+func (p *PieceTable[T]) Set(idx int, e T) (T, bool) {
+	old, ok := p.Get(idx)
+	if !ok {
+		return old, false
+	}
+	es := []T{e}
+	p.history.record(op[T]{idx: idx, removed: []T{old}, inserted: es})
+	p.remove(idx, idx+1)
+	p.insert(idx, es)
+	return old, true
+}
+
+// Insert inserts elements before idx, appends them if idx is out of range.
+func (p *PieceTable[T]) Insert(idx int, e ...T) {
+	if idx < 0 || idx > p.length {
+		idx = p.length
+	}
+	es := append([]T(nil), e...)
+	p.history.record(op[T]{idx: idx, inserted: es})
+	p.insert(idx, es)
+}
+
+// Remove removes the element at idx, ignored if out of range.
+func (p *PieceTable[T]) Remove(idx int) {
+	if idx < 0 || idx >= p.length {
+		return
+	}
+	p.RemoveRange(idx, idx+1)
+}
+
+// RemoveRange removes elements in range [from, to), the range is clamped.
+func (p *PieceTable[T]) RemoveRange(from, to int) {
+	from, to = clamp(from, to, p.length)
+	p.history.record(op[T]{idx: from, removed: p.remove(from, to)})
+}
+
+// Clear removes all elements, it can be undone.
+func (p *PieceTable[T]) Clear() {
+	p.RemoveRange(0, p.length)
+}
+
+// Range calls fn for each element in order until fn returns false.
+func (p *PieceTable[T]) Range(fn func(e T) bool) {
+	for _, pc := range p.pieces {
+		for _, e := range p.buffer(pc) {
+			if !fn(e) {
+				return
+			}
+		}
+	}
+}
+
+// Undo reverts the latest edit, false if there is nothing to undo.
+func (p *PieceTable[T]) Undo() bool {
+	return p.history.undo(p)
+}
+
+// Redo reapplies the latest undone edit, false if there is nothing to redo.
+func (p *PieceTable[T]) Redo() bool {
+	return p.history.redo(p)
+}
+
+func (p *PieceTable[T]) insert(idx int, es []T) {
+	if len(es) == 0 {
+		return
+	}
+	pc := piece{add: true, start: len(p.added), length: len(es)}
+	p.added = append(p.added, es...)
+	p.length += len(es)
+
+	i, off := p.locate(idx)
+	// typing forward extends the previous piece instead of adding one
+	if off == 0 && i > 0 {
+		if last := &p.pieces[i-1]; last.add && last.start+last.length == pc.start {
+			last.length += pc.length
+			return
+		}
+	}
+	if off == 0 {
+		p.pieces = append(p.pieces, piece{})
+		copy(p.pieces[i+1:], p.pieces[i:])
+		p.pieces[i] = pc
+		return
+	}
+	// split the piece around the inserted one
+	left, right := p.pieces[i], p.pieces[i]
+	left.length = off
+	right.start += off
+	right.length -= off
+	p.pieces = append(p.pieces, piece{}, piece{})
+	copy(p.pieces[i+3:], p.pieces[i+1:])
+	p.pieces[i], p.pieces[i+1], p.pieces[i+2] = left, pc, right
+}
+
+func (p *PieceTable[T]) remove(from, to int) []T {
+	if from >= to {
+		return nil
+	}
+	removed := make([]T, 0, to-from)
+	pieces := make([]piece, 0, len(p.pieces)+1)
+	pos := 0
+	for _, pc := range p.pieces {
+		lo, hi := max(from-pos, 0), min(to-pos, pc.length)
+		pos += pc.length
+		if lo >= hi {
+			pieces = append(pieces, pc)
+			continue
+		}
+		removed = append(removed, p.buffer(pc)[lo:hi]...)
+		if lo > 0 {
+			pieces = append(pieces, piece{add: pc.add, start: pc.start, length: lo})
+		}
+		if hi < pc.length {
+			pieces = append(pieces, piece{add: pc.add, start: pc.start + hi, length: pc.length - hi})
+		}
+	}
+	p.pieces = pieces
+	p.length -= to - from
+	return removed
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func ExampleOrdered() {
+	compare := Ordered[int]()
+	fmt.Println(compare(1, 2), compare(2, 2), compare(3, 2))
+	// Output:
+	// -1 0 1
+}
+
+func ExampleComparator_Reverse() {
+	es := []int{3, 1, 2}
+	less := Ordered[int]().Reverse().Less()
+	sort.Slice(es, func(i, j int) bool { return less(es[i], es[j]) })
+	fmt.Println(es)
+	// Output:
+	// [3 2 1]
+}
+
+func ExampleComparator_ThenComparing() {
+	ps := []person{{"bob", 30}, {"amy", 30}, {"cat", 20}}
+	compare := By(func(p person) int { return p.age }).
+		ThenComparing(By(func(p person) string { return p.name }))
+	less := compare.Less()
+	sort.Slice(ps, func(i, j int) bool { return less(ps[i], ps[j]) })
+	fmt.Println(ps)
+	// Output:
+	// [{cat 20} {amy 30} {bob 30}]
+}
+
+func ExampleByFunc() {
+	compare := ByFunc(func(p person) string { return p.name }, Ordered[string]().Reverse())
+	fmt.Println(compare(person{name: "amy"}, person{name: "bob"}))
+	// Output:
+	// 1
+}
+
+func ExampleNilsFirst() {
+	one, two := 1, 2
+	es := []*int{&two, nil, &one}
+	less := NilsFirst(Ordered[int]()).Less()
+	sort.Slice(es, func(i, j int) bool { return less(es[i], es[j]) })
+	fmt.Println(es[0], *es[1], *es[2])
+	// Output:
+	// <nil> 1 2
+}
+
+func ExampleNilsLast() {
+	one, two := 1, 2
+	es := []*int{nil, &two, &one}
+	less := NilsLast(Ordered[int]()).Less()
+	sort.Slice(es, func(i, j int) bool { return less(es[i], es[j]) })
+	fmt.Println(*es[0], *es[1], es[2])
+	// Output:
+	// 1 2 <nil>
+}
+
+func ExampleLess_Comparator() {
+	var less Less[string] = func(i, j string) bool { return len(i) < len(j) }
+	compare, equal := less.Comparator(), less.Equaler()
+	fmt.Println(compare("a", "bb"), compare("bb", "cc"), compare("ccc", "a"))
+	fmt.Println(equal("ab", "cd"), equal("a", "cd"))
+	// Output:
+	// -1 0 1
+	// true false
+}
+
+func ExampleComparator_Equaler() {
+	equal := Ordered[int]().Equaler()
+	fmt.Println(equal(1, 1), equal(1, 2))
+	// Output:
+	// true false
+}
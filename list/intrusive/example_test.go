@@ -0,0 +1,191 @@
+package intrusive
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list/linkedlist"
+)
+
+type task struct {
+	name       string
+	queue, all Link[task]
+}
+
+func queueLink(t *task) *Link[task] { return &t.queue }
+func allLink(t *task) *Link[task]   { return &t.all }
+
+func names(l *List[task]) []string {
+	var ns []string
+	l.Range(func(t *task) bool {
+		ns = append(ns, t.name)
+		return true
+	})
+	return ns
+}
+
+func Example() {
+	queue, all := New(queueLink), New(allLink)
+	a, b, c := &task{name: "a"}, &task{name: "b"}, &task{name: "c"}
+	for _, t := range []*task{a, b, c} {
+		all.PushBack(t)
+		queue.PushFront(t)
+	}
+	queue.Remove(b)
+	fmt.Println(names(all), names(queue))
+	fmt.Println(b.all.Linked(), b.queue.Linked())
+	// Output:
+	// [a b c] [c a]
+	// true false
+}
+
+func ExampleList_PushBack() {
+	l := New(queueLink)
+	a := &task{name: "a"}
+	fmt.Println(l.PushBack(a))
+	// an element is linked at most once through the same link
+	fmt.Println(l.PushBack(a))
+	fmt.Println(New(queueLink).PushBack(a))
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleList_MoveToFront() {
+	l := New(queueLink)
+	ts := []*task{{name: "a"}, {name: "b"}, {name: "c"}}
+	for _, t := range ts {
+		l.PushBack(t)
+	}
+	l.MoveToFront(ts[2])
+	l.MoveAfter(ts[0], ts[1])
+	fmt.Println(names(l))
+	// Output:
+	// [c b a]
+}
+
+// check compares the list in both directions against want.
+func check(t *testing.T, l *List[task], want []*task) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	i := 0
+	for e := l.Front(); e != nil; e = l.Next(e) {
+		if e != want[i] {
+			t.Fatalf("element %d = %s, want %s", i, e.name, want[i].name)
+		}
+		i++
+	}
+	for e := l.Back(); e != nil; e = l.Prev(e) {
+		i--
+		if e != want[i] {
+			t.Fatalf("element %d from back = %s, want %s", i, e.name, want[i].name)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	l := New(queueLink)
+	var want []*task
+	pool := make([]*task, 20)
+	for i := range pool {
+		pool[i] = &task{name: fmt.Sprint(i)}
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		e, mark := pool[r.Intn(len(pool))], pool[r.Intn(len(pool))]
+		in := slices.Index(want, e)
+		at := slices.Index(want, mark)
+		switch r.Intn(6) {
+		case 0:
+			if l.PushFront(e) != (in < 0) {
+				t.Fatalf("PushFront(%s) inserted = %v", e.name, in >= 0)
+			}
+			if in < 0 {
+				want = slices.Insert(want, 0, e)
+			}
+		case 1:
+			if l.InsertAfter(e, mark) != (in < 0 && at >= 0) {
+				t.Fatalf("InsertAfter(%s, %s) wrong result", e.name, mark.name)
+			}
+			if in < 0 && at >= 0 {
+				want = slices.Insert(want, at+1, e)
+			}
+		case 2:
+			l.Remove(e)
+			if in >= 0 {
+				want = slices.Delete(want, in, in+1)
+			}
+		case 3:
+			l.MoveToBack(e)
+			if in >= 0 {
+				want = append(slices.Delete(want, in, in+1), e)
+			}
+		case 4:
+			l.MoveBefore(e, mark)
+			if in >= 0 && at >= 0 && e != mark {
+				want = slices.Delete(want, in, in+1)
+				want = slices.Insert(want, slices.Index(want, mark), e)
+			}
+		case 5:
+			if l.PushBack(e) != (in < 0) {
+				t.Fatalf("PushBack(%s) inserted = %v", e.name, in >= 0)
+			}
+			if in < 0 {
+				want = append(want, e)
+			}
+		}
+		check(t, l, want)
+	}
+
+	l.Clear()
+	check(t, l, nil)
+	for _, e := range pool {
+		if e.queue.Linked() {
+			t.Fatalf("%s linked after Clear", e.name)
+		}
+	}
+}
+
+func TestForEachRemove(t *testing.T) {
+	l := New(queueLink)
+	ts := []*task{{name: "a"}, {name: "b"}, {name: "c"}, {name: "d"}}
+	for _, e := range ts {
+		l.PushBack(e)
+	}
+	l.ForEach(func(e *task) {
+		if e.name != "c" {
+			l.Remove(e)
+		}
+	})
+	check(t, l, ts[2:3])
+}
+
+func BenchmarkPushRemove(b *testing.B) {
+	ts := make([]task, 1024)
+	b.Run("Intrusive", func(b *testing.B) {
+		l := New(queueLink)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			e := &ts[i%len(ts)]
+			l.PushBack(e)
+			if l.Len() == len(ts) {
+				l.Remove(l.Front())
+			}
+		}
+	})
+	b.Run("LinkedList", func(b *testing.B) {
+		l := linkedlist.New[*task]()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l.PushBack(&ts[i%len(ts)])
+			if l.Len() == len(ts) {
+				l.Remove(l.Front())
+			}
+		}
+	})
+}
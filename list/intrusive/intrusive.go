@@ -0,0 +1,206 @@
+// Package intrusive implements a doubly linked list whose links live in
+// the elements themselves, so that linking an element allocates nothing.
+//
+// A struct embeds one Link field per list it may belong to, and each list
+// is created with an accessor returning the matching field:
+//
+//	type Task struct {
+//		Name       string
+//		queue, all intrusive.Link[Task]
+//	}
+//
+//	queue := intrusive.New(func(t *Task) *intrusive.Link[Task] { return &t.queue })
+//	all := intrusive.New(func(t *Task) *intrusive.Link[Task] { return &t.all })
+package intrusive
+
+// Link holds the position of an element in one list. The zero value is
+// an unlinked link.
+type Link[T any] struct {
+	prev, next *T
+	list       *List[T]
+}
+
+// Linked reports whether the element is in a list through this link.
+func (k *Link[T]) Linked() bool { return k.list != nil }
+
+// List represents a doubly linked list of *T.
+//
+// Methods taking an element ignore elements held by other lists through
+// the same link, and elements not in the list.
+type List[T any] struct {
+	head, tail *T
+	length     int
+	link       func(*T) *Link[T]
+}
+
+// New returns an empty list linking elements through the field returned by link.
+func New[T any](link func(*T) *Link[T]) *List[T] {
+	return &List[T]{link: link}
+}
+
+// Contains reports whether the element is in the list.
+func (l *List[T]) Contains(e *T) bool {
+	return e != nil && l.link(e).list == l
+}
+
+func (l *List[T]) Len() int { return l.length }
+
+func (l *List[T]) Front() *T { return l.head }
+
+func (l *List[T]) Back() *T { return l.tail }
+
+// Next returns the element after 'e', nil at the back or if 'e' is not in the list.
+func (l *List[T]) Next(e *T) *T {
+	if !l.Contains(e) {
+		return nil
+	}
+	return l.link(e).next
+}
+
+// Prev returns the element before 'e', nil at the front or if 'e' is not in the list.
+func (l *List[T]) Prev(e *T) *T {
+	if !l.Contains(e) {
+		return nil
+	}
+	return l.link(e).prev
+}
+
+// insert links the unlinked 'e' between prev and next, either may be nil at the ends.
+func (l *List[T]) insert(e, prev, next *T) {
+	k := l.link(e)
+	k.prev, k.next, k.list = prev, next, l
+	if prev == nil {
+		l.head = e
+	} else {
+		l.link(prev).next = e
+	}
+	if next == nil {
+		l.tail = e
+	} else {
+		l.link(next).prev = e
+	}
+	l.length++
+}
+
+// unlink detaches 'e' and resets its link.
+func (l *List[T]) unlink(e *T) {
+	k := l.link(e)
+	if k.prev == nil {
+		l.head = k.next
+	} else {
+		l.link(k.prev).next = k.next
+	}
+	if k.next == nil {
+		l.tail = k.prev
+	} else {
+		l.link(k.next).prev = k.prev
+	}
+	*k = Link[T]{}
+	l.length--
+}
+
+// linkable reports whether 'e' can be inserted, it must not be linked yet.
+func (l *List[T]) linkable(e *T) bool {
+	return e != nil && !l.link(e).Linked()
+}
+
+// PushFront reports whether 'e' was inserted, false if it is already linked.
+func (l *List[T]) PushFront(e *T) bool {
+	if !l.linkable(e) {
+		return false
+	}
+	l.insert(e, nil, l.head)
+	return true
+}
+
+// PushBack reports whether 'e' was inserted, false if it is already linked.
+func (l *List[T]) PushBack(e *T) bool {
+	if !l.linkable(e) {
+		return false
+	}
+	l.insert(e, l.tail, nil)
+	return true
+}
+
+// InsertBefore reports whether 'e' was inserted, false if it is already
+// linked or 'mark' is not in the list.
+func (l *List[T]) InsertBefore(e, mark *T) bool {
+	if !l.linkable(e) || !l.Contains(mark) {
+		return false
+	}
+	l.insert(e, l.link(mark).prev, mark)
+	return true
+}
+
+// InsertAfter reports whether 'e' was inserted, false if it is already
+// linked or 'mark' is not in the list.
+func (l *List[T]) InsertAfter(e, mark *T) bool {
+	if !l.linkable(e) || !l.Contains(mark) {
+		return false
+	}
+	l.insert(e, mark, l.link(mark).next)
+	return true
+}
+
+// Remove unlinks 'e', it can be inserted again afterwards.
+func (l *List[T]) Remove(e *T) {
+	if l.Contains(e) {
+		l.unlink(e)
+	}
+}
+
+func (l *List[T]) MoveToFront(e *T) {
+	if l.Contains(e) && l.head != e {
+		l.unlink(e)
+		l.insert(e, nil, l.head)
+	}
+}
+
+func (l *List[T]) MoveToBack(e *T) {
+	if l.Contains(e) && l.tail != e {
+		l.unlink(e)
+		l.insert(e, l.tail, nil)
+	}
+}
+
+func (l *List[T]) MoveBefore(e, mark *T) {
+	if l.Contains(e) && l.Contains(mark) && e != mark {
+		l.unlink(e)
+		l.insert(e, l.link(mark).prev, mark)
+	}
+}
+
+func (l *List[T]) MoveAfter(e, mark *T) {
+	if l.Contains(e) && l.Contains(mark) && e != mark {
+		l.unlink(e)
+		l.insert(e, mark, l.link(mark).next)
+	}
+}
+
+// ForEach calls fn for each element from front to back, fn may remove the element.
+func (l *List[T]) ForEach(fn func(e *T)) {
+	for e := l.head; e != nil; {
+		next := l.link(e).next
+		fn(e)
+		e = next
+	}
+}
+
+// Range calls fn for each element from front to back until fn returns false.
+func (l *List[T]) Range(fn func(e *T) bool) {
+	for e := l.head; e != nil; e = l.link(e).next {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// Clear unlinks all elements, it walks the list to reset their links.
+func (l *List[T]) Clear() {
+	for e := l.head; e != nil; {
+		k := l.link(e)
+		e = k.next
+		*k = Link[T]{}
+	}
+	l.head, l.tail, l.length = nil, nil, 0
+}
@@ -1,6 +1,15 @@
 package linkedlist
 
-import "fmt"
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/list/listtest"
+)
 
 func Output(l *LinkedList[int]) {
 	l.ForEach(func(n *Node[int]) { fmt.Printf("%d -> ", n.Value) })
@@ -147,3 +156,249 @@ func ExampleLinkedList_ForEach() {
 	// Output:
 	// 1 -> 2 -> 3 -> 4 -> 5 -> 1
 }
+
+func TestLinkedList(t *testing.T) {
+	listtest.TestList(t, func() list.List[int] { return New[int]() })
+}
+
+func ExampleLinkedList_PushBackList() {
+	l := LinkedList_New()
+	l.PushBackList(l)
+	l.PushFrontList(LinkedList_New())
+	Output(l)
+	// Output:
+	// 0 -> 1 -> 2 -> 3 -> 4 -> 0 -> 1 -> 2 -> 3 -> 4 -> 0 -> 1 -> 2 -> 3 -> 4 -> 0
+}
+
+func ExampleLinkedList_Splice() {
+	l, other := LinkedList_New(), LinkedList_New()
+	l.Splice(other, l.Front().Next())
+	Output(l)
+	fmt.Println()
+	fmt.Println(l.Len(), other.Len())
+	// Output:
+	// 0 -> 0 -> 1 -> 2 -> 3 -> 4 -> 1 -> 2 -> 3 -> 4 -> 0
+	// 10 0
+}
+
+func ExampleLinkedList_SpliceRange() {
+	l, other := LinkedList_New(), LinkedList_New()
+	first := other.Front().Next()
+	last := first.Next().Next()
+	l.SpliceRange(other, first, last, nil)
+	Output(l)
+	fmt.Println()
+	Output(other)
+	fmt.Println()
+	fmt.Println(l.Len(), other.Len())
+	// Output:
+	// 0 -> 1 -> 2 -> 3 -> 4 -> 1 -> 2 -> 3 -> 0
+	// 0 -> 4 -> 0
+	// 8 2
+}
+
+func ExampleLinkedList_Reverse() {
+	l := LinkedList_New()
+	l.Reverse()
+	Output(l)
+	// Output:
+	// 4 -> 3 -> 2 -> 1 -> 0 -> 4
+}
+
+func ExampleLinkedList_Rotate() {
+	l := LinkedList_New()
+	l.Rotate(2)
+	Output(l)
+	fmt.Println()
+	l.Rotate(-3)
+	Output(l)
+	// Output:
+	// 3 -> 4 -> 0 -> 1 -> 2 -> 3
+	// 1 -> 2 -> 3 -> 4 -> 0 -> 1
+}
+
+func ExampleLinkedList_Remove_detached() {
+	l, other := LinkedList_New(), LinkedList_New()
+	n := l.Front()
+	other.Remove(n)
+	fmt.Println(other.InsertBefore(8, n), l.Len(), other.Len())
+	l.Remove(n)
+	l.Remove(n)
+	fmt.Println(n.Next(), n.Prev(), l.Len())
+	// Output:
+	// <nil> 5 5
+	// <nil> <nil> 4
+}
+
+func ExampleLinkedList_Clear() {
+	l := LinkedList_New()
+	n := l.Front()
+	l.Clear()
+	l.PushBack(9)
+	l.MoveToBack(n)
+	fmt.Println(n.Next(), l.Len(), l.Front().Value)
+	// Output:
+	// <nil> 1 9
+}
+
+func TestLinkedListOwnership(t *testing.T) {
+	a, b, c := LinkedList_New(), LinkedList_New(), LinkedList_New()
+	nb, nc := b.Front(), c.Back()
+	b.Splice(c, nil)
+	a.Splice(b, a.Front())
+	if b.Len() != 0 || c.Len() != 0 || a.Len() != 15 {
+		t.Fatalf("lengths %d %d %d after splicing", a.Len(), b.Len(), c.Len())
+	}
+	// spliced nodes belong to the destination list
+	b.MoveToFront(nb)
+	c.Remove(nc)
+	if a.Len() != 15 || a.Front() != nb || nc.Next() == nil || nc.Next().Value != 0 {
+		t.Fatalf("nodes spliced into a were modified through their old lists")
+	}
+	a.MoveToFront(nc)
+	a.Remove(nb)
+	if a.Front() != nc || a.Len() != 14 || nb.Next() != nil {
+		t.Fatalf("nodes spliced into a are not owned by a")
+	}
+	// a range spliced back into an emptied list
+	b.PushBack(100)
+	a.SpliceRange(b, b.Front(), b.Back(), nil)
+	if a.Back().Value != 100 || b.Len() != 0 || a.Len() != 15 {
+		t.Fatalf("SpliceRange(b) = %v, lengths %d %d", a.Back().Value, a.Len(), b.Len())
+	}
+	// invalid ranges are ignored
+	a.SpliceRange(a, a.Back(), a.Front(), nil)
+	a.SpliceRange(a, a.Front(), a.Back(), a.Front().Next())
+	if a.Len() != 15 || a.Front() != nc {
+		t.Fatalf("invalid SpliceRange modified the list")
+	}
+	a.Clear()
+	if nc.Next() != nil || nc.Prev() != nil {
+		t.Fatalf("nodes of a cleared list are not detached")
+	}
+	var count int
+	a.ForEach(func(*Node[int]) { count++ })
+	if count != 0 {
+		t.Fatalf("ForEach visits %d nodes of a cleared list", count)
+	}
+}
+
+func ExampleLinkedList_Sort() {
+	l := New[int]()
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		l.PushBack(v)
+	}
+	l.Sort(list.Ordered[int]().Less())
+	Output(l)
+	fmt.Println()
+	fmt.Println(l.IsSorted(list.Ordered[int]().Less()))
+	// Output:
+	// 1 -> 1 -> 2 -> 3 -> 4 -> 5 -> 6 -> 9 -> 1
+	// true
+}
+
+func ExampleLinkedList_Merge() {
+	l, other := New[int](), New[int]()
+	l.Append(1, 3, 5, 7)
+	other.Append(2, 3, 6, 8, 9)
+	l.Merge(other, list.Ordered[int]().Less())
+	Output(l)
+	fmt.Println()
+	fmt.Println(l.Len(), other.Len())
+	// Output:
+	// 1 -> 2 -> 3 -> 3 -> 5 -> 6 -> 7 -> 8 -> 9 -> 1
+	// 9 0
+}
+
+func ExampleLinkedList_Find() {
+	l := New[int]()
+	l.Append(1, 2, 3, 2, 1)
+	first := l.Find(2, list.Ordered[int]().Equaler())
+	last := l.FindLast(2, list.Ordered[int]().Equaler())
+	fmt.Println(first.Value, first.Next().Value, last.Value, last.Next().Value)
+	fmt.Println(l.Find(9, list.Ordered[int]().Equaler()))
+	// Output:
+	// 2 3 2 1
+	// <nil>
+}
+
+func ExampleLinkedList_RemoveIf() {
+	l := LinkedList_New()
+	fmt.Println(l.RemoveIf(func(v int) bool { return v%2 == 0 }))
+	Output(l)
+	// Output:
+	// 3
+	// 1 -> 3 -> 1
+}
+
+func ExampleLinkedList_Unique() {
+	l := New[int]()
+	l.Append(1, 1, 2, 2, 2, 3, 1, 1)
+	fmt.Println(l.Unique(list.Ordered[int]().Equaler()))
+	Output(l)
+	// Output:
+	// 4
+	// 1 -> 2 -> 3 -> 1 -> 1
+}
+
+func TestLinkedListSort(t *testing.T) {
+	type record struct{ key, seq int }
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 7, 64, 1000} {
+		l := New[record]()
+		var ref []record
+		for i := 0; i < n; i++ {
+			e := record{r.Intn(10), i}
+			l.PushBack(e)
+			ref = append(ref, e)
+		}
+		l.Sort(list.By(func(e record) int { return e.key }).Less())
+		slices.SortStableFunc(ref, func(i, j record) int { return i.key - j.key })
+
+		var got []record
+		l.Range(func(e record) bool {
+			got = append(got, e)
+			return true
+		})
+		if !slices.Equal(got, ref) || l.Len() != n {
+			t.Fatalf("Sort() of %d records = %v, want %v", n, got, ref)
+		}
+		// prev pointers are consistent
+		i := n - 1
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if e.Value != ref[i] {
+				t.Fatalf("backward walk at %d = %v, want %v", i, e.Value, ref[i])
+			}
+			i--
+		}
+	}
+}
+
+// readers walking a list concurrently must not write to its nodes, run
+// with -race
+func TestConcurrentWalk(t *testing.T) {
+	l, other := New[int](), New[int]()
+	for i := 0; i < 100; i++ {
+		other.PushBack(i)
+	}
+	// nodes of other now reach l through a forwarded owner
+	l.Splice(other, nil)
+	var wg sync.WaitGroup
+	for g := 0; g < 2; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			count := 0
+			for n := l.Front(); n != nil; n = n.Next() {
+				count++
+			}
+			for n := l.Back(); n != nil; n = n.Prev() {
+				count++
+			}
+			if count != 200 {
+				t.Errorf("walked %d nodes, want 200", count)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,38 +1,72 @@
 package linkedlist
 
+import (
+	"go-data-structure/internal/owner"
+	"go-data-structure/list"
+)
+
+var _ list.List[int] = (*LinkedList[int])(nil)
+
 // Node is an element of a linked list.
+//
+// Nodes are tagged with the owner of their list. Splicing a whole list
+// forwards its owner to the owner of the destination list, so that the
+// moved nodes change hands in O(1).
 type Node[T any] struct {
 	prev, next *Node[T]
+	owner      *owner.Owner[LinkedList[T]]
 	Value      T
 }
 
+// list returns the list holding the node, nil if the node is detached.
+// It only reads, so that readers walking a list concurrently do not race.
+func (n *Node[T]) list() *LinkedList[T] {
+	return owner.Find(n.owner).Container()
+}
+
+// Prev returns the previous node, nil at the front or if the node is detached.
 func (n *Node[T]) Prev() *Node[T] {
-	if n.prev == n {
-		return nil
+	if l := n.list(); l != nil && n.prev != &l.root {
+		return n.prev
 	}
-	return n.prev
+	return nil
 }
 
+// Next returns the next node, nil at the back or if the node is detached.
 func (n *Node[T]) Next() *Node[T] {
-	if n.next == n {
-		return nil
+	if l := n.list(); l != nil && n.next != &l.root {
+		return n.next
 	}
-	return n.next
+	return nil
 }
 
 // LinkedList represents a doubly linked list.
+//
+// Methods taking a node ignore nodes of other lists and removed nodes.
 type LinkedList[T any] struct {
 	root   Node[T]
 	length int
+	owner  *owner.Owner[LinkedList[T]]
 }
 
 func New[T any]() *LinkedList[T] {
 	l := &LinkedList[T]{}
 	l.root.prev = &l.root
 	l.root.next = &l.root
+	l.owner = owner.New(l)
 	return l
 }
 
+// owns reports whether the node is held by the list, it is called by
+// mutating methods only and compresses the owner path of the node.
+func (l *LinkedList[T]) owns(n *Node[T]) bool {
+	if n == nil {
+		return false
+	}
+	n.owner = owner.Resolve(n.owner)
+	return n.owner.Container() == l
+}
+
 func (l *LinkedList[T]) Len() int { return l.length }
 
 func (l *LinkedList[T]) Front() *Node[T] {
@@ -49,15 +83,17 @@ func (l *LinkedList[T]) Back() *Node[T] {
 	return l.root.prev
 }
 
-func (l *LinkedList[T]) insert(v T, n *Node[T]) {
+func (l *LinkedList[T]) insert(v T, n *Node[T]) *Node[T] {
 	e := &Node[T]{
 		prev:  n,
 		next:  n.next,
+		owner: l.owner,
 		Value: v,
 	}
 	e.prev.next = e
 	e.next.prev = e
 	l.length = l.length + 1
+	return e
 }
 
 func (l *LinkedList[T]) move(e, n *Node[T]) {
@@ -72,28 +108,311 @@ func (l *LinkedList[T]) move(e, n *Node[T]) {
 	e.next.prev = e
 }
 
-func (l *LinkedList[T]) PushFront(v T)                { l.insert(v, &l.root) }
-func (l *LinkedList[T]) PushBack(v T)                 { l.insert(v, l.root.prev) }
-func (l *LinkedList[T]) InsertBefore(v T, n *Node[T]) { l.insert(v, n.prev) }
-func (l *LinkedList[T]) InsertAfter(v T, n *Node[T])  { l.insert(v, n) }
-func (l *LinkedList[T]) MoveToFront(n *Node[T])       { l.move(n, &l.root) }
-func (l *LinkedList[T]) MoveToBack(n *Node[T])        { l.move(n, l.root.prev) }
-func (l *LinkedList[T]) MoveBefore(e, n *Node[T])     { l.move(e, n.prev) }
-func (l *LinkedList[T]) MoveAfter(e, n *Node[T])      { l.move(e, n) }
+func (l *LinkedList[T]) PushFront(v T) *Node[T] { return l.insert(v, &l.root) }
+func (l *LinkedList[T]) PushBack(v T) *Node[T]  { return l.insert(v, l.root.prev) }
+
+// InsertBefore returns the inserted node, nil if 'n' is not in the list.
+func (l *LinkedList[T]) InsertBefore(v T, n *Node[T]) *Node[T] {
+	if !l.owns(n) {
+		return nil
+	}
+	return l.insert(v, n.prev)
+}
+
+// InsertAfter returns the inserted node, nil if 'n' is not in the list.
+func (l *LinkedList[T]) InsertAfter(v T, n *Node[T]) *Node[T] {
+	if !l.owns(n) {
+		return nil
+	}
+	return l.insert(v, n)
+}
+
+func (l *LinkedList[T]) MoveToFront(n *Node[T]) {
+	if l.owns(n) {
+		l.move(n, &l.root)
+	}
+}
+
+func (l *LinkedList[T]) MoveToBack(n *Node[T]) {
+	if l.owns(n) {
+		l.move(n, l.root.prev)
+	}
+}
 
+func (l *LinkedList[T]) MoveBefore(e, n *Node[T]) {
+	if l.owns(e) && l.owns(n) {
+		l.move(e, n.prev)
+	}
+}
+
+func (l *LinkedList[T]) MoveAfter(e, n *Node[T]) {
+	if l.owns(e) && l.owns(n) {
+		l.move(e, n)
+	}
+}
+
+// Remove detaches the node, its Next and Prev return nil afterwards.
 func (l *LinkedList[T]) Remove(n *Node[T]) {
-	if n == nil {
+	if !l.owns(n) {
 		return
 	}
 	n.prev.next = n.next
 	n.next.prev = n.prev
 	n.prev = nil
 	n.next = nil
+	n.owner = nil
 	l.length = l.length - 1
 }
 
+// ForEach calls fn for each node from front to back, fn may remove the node.
 func (l *LinkedList[T]) ForEach(fn func(*Node[T])) {
-	for n := l.Front(); n != &l.root; n = n.Next() {
+	for n := l.root.next; n != &l.root; {
+		next := n.next
 		fn(n)
+		n = next
+	}
+}
+
+// Clear removes all nodes from the list, they become detached.
+func (l *LinkedList[T]) Clear() {
+	l.root.prev = &l.root
+	l.root.next = &l.root
+	l.length = 0
+	l.owner = l.owner.Release()
+}
+
+// Range calls fn for each value from front to back until fn returns false.
+func (l *LinkedList[T]) Range(fn func(e T) bool) {
+	for n := l.root.next; n != &l.root; n = n.next {
+		if !fn(n.Value) {
+			return
+		}
+	}
+}
+
+// node returns the node at idx walking from the nearer end, nil if out of range.
+func (l *LinkedList[T]) node(idx int) *Node[T] {
+	if idx < 0 || idx >= l.length {
+		return nil
+	}
+	n := &l.root
+	if idx < l.length/2 {
+		for i := 0; i <= idx; i++ {
+			n = n.next
+		}
+	} else {
+		for i := l.length; i > idx; i-- {
+			n = n.prev
+		}
+	}
+	return n
+}
+
+// Get returns the value at idx, false if out of range.
+func (l *LinkedList[T]) Get(idx int) (T, bool) {
+	n := l.node(idx)
+	if n == nil {
+		var v T
+		return v, false
+	}
+	return n.Value, true
+}
+
+// Set replaces the value at idx and returns the replaced one, false if out of range.
+func (l *LinkedList[T]) Set(idx int, v T) (T, bool) {
+	n := l.node(idx)
+	if n == nil {
+		var e T
+		return e, false
+	}
+	e := n.Value
+	n.Value = v
+	return e, true
+}
+
+// Insert inserts values before idx, appends them if idx is out of range.
+func (l *LinkedList[T]) Insert(idx int, v ...T) {
+	at := l.node(idx)
+	if at == nil {
+		at = &l.root
+	}
+	for _, e := range v {
+		l.insert(e, at.prev)
+	}
+}
+
+// Append appends values to the back of the list.
+func (l *LinkedList[T]) Append(v ...T) {
+	for _, e := range v {
+		l.PushBack(e)
+	}
+}
+
+// RemoveAt removes and returns the value at idx, false if out of range.
+func (l *LinkedList[T]) RemoveAt(idx int) (T, bool) {
+	n := l.node(idx)
+	if n == nil {
+		var v T
+		return v, false
+	}
+	l.Remove(n)
+	return n.Value, true
+}
+
+// PushBackList appends copies of the values of other, which may be the list itself.
+func (l *LinkedList[T]) PushBackList(other *LinkedList[T]) {
+	for i, n := other.Len(), other.root.next; i > 0; i, n = i-1, n.next {
+		l.PushBack(n.Value)
+	}
+}
+
+// PushFrontList prepends copies of the values of other, which may be the list itself.
+func (l *LinkedList[T]) PushFrontList(other *LinkedList[T]) {
+	for i, n := other.Len(), other.root.prev; i > 0; i, n = i-1, n.prev {
+		l.PushFront(n.Value)
+	}
+}
+
+// link inserts the detached chain first..last of 'count' nodes before 'at'.
+func (l *LinkedList[T]) link(first, last, at *Node[T], count int) {
+	first.prev = at.prev
+	last.next = at
+	at.prev.next = first
+	at.prev = last
+	l.length = l.length + count
+}
+
+// unlink detaches the chain first..last of 'count' nodes.
+func (l *LinkedList[T]) unlink(first, last *Node[T], count int) {
+	first.prev.next = last.next
+	last.next.prev = first.prev
+	l.length = l.length - count
+}
+
+// Splice moves all nodes of other before 'at' in O(1), a nil 'at' moves
+// them to the back. other is left empty.
+func (l *LinkedList[T]) Splice(other *LinkedList[T], at *Node[T]) {
+	if other == l || other.length == 0 {
+		return
+	}
+	if at == nil {
+		at = &l.root
+	} else if !l.owns(at) {
+		return
+	}
+	first, last, count := other.root.next, other.root.prev, other.length
+	other.unlink(first, last, count)
+	l.link(first, last, at, count)
+	// moved nodes resolve their owner to the list through the old owner of other
+	other.owner = other.owner.Forward(l.owner)
+}
+
+// SpliceRange moves the nodes from first to last inclusive of other before
+// 'at', a nil 'at' moves them to the back. It walks the range to count
+// its nodes, nothing moves if the range is not in other or 'at' is in it.
+func (l *LinkedList[T]) SpliceRange(other *LinkedList[T], first, last, at *Node[T]) {
+	if at == nil {
+		at = &l.root
+	} else if !l.owns(at) {
+		return
+	}
+	if !other.owns(first) || !other.owns(last) {
+		return
+	}
+	count := 1
+	for n := first; n != last; n = n.next {
+		if n == &other.root || n == at {
+			return
+		}
+		count++
+	}
+	if last == at {
+		return
+	}
+	other.unlink(first, last, count)
+	l.link(first, last, at, count)
+	for n := first; n != at; n = n.next {
+		n.owner = l.owner
+	}
+}
+
+// Reverse reverses the order of nodes in place.
+func (l *LinkedList[T]) Reverse() {
+	n := &l.root
+	for {
+		n.prev, n.next = n.next, n.prev
+		n = n.prev
+		if n == &l.root {
+			return
+		}
+	}
+}
+
+// Rotate moves nodes 'k' positions towards the back in place, wrapping
+// around to the front, negative 'k' rotates towards the front.
+func (l *LinkedList[T]) Rotate(k int) {
+	if l.length == 0 {
+		return
+	}
+	k = ((k % l.length) + l.length) % l.length
+	if k == 0 {
+		return
+	}
+	// the node at 'length-k' becomes the front, relink the root before it
+	front := l.node(l.length - k)
+	root := &l.root
+	root.prev.next = root.next
+	root.next.prev = root.prev
+	root.prev = front.prev
+	root.next = front
+	front.prev.next = root
+	front.prev = root
+}
+
+// Find returns the first node whose value equals 'v', nil if not present.
+func (l *LinkedList[T]) Find(v T, equal list.Equaler[T]) *Node[T] {
+	for n := l.root.next; n != &l.root; n = n.next {
+		if equal(n.Value, v) {
+			return n
+		}
+	}
+	return nil
+}
+
+// FindLast returns the last node whose value equals 'v', nil if not present.
+func (l *LinkedList[T]) FindLast(v T, equal list.Equaler[T]) *Node[T] {
+	for n := l.root.prev; n != &l.root; n = n.prev {
+		if equal(n.Value, v) {
+			return n
+		}
+	}
+	return nil
+}
+
+// RemoveIf removes all nodes whose value satisfies pred, returns the number
+// of removed nodes.
+func (l *LinkedList[T]) RemoveIf(pred func(v T) bool) int {
+	removed := 0
+	l.ForEach(func(n *Node[T]) {
+		if pred(n.Value) {
+			l.Remove(n)
+			removed++
+		}
+	})
+	return removed
+}
+
+// Unique removes nodes whose value equals the one of the previous node,
+// returns the number of removed nodes.
+func (l *LinkedList[T]) Unique(equal list.Equaler[T]) int {
+	removed := 0
+	for n := l.root.next; n != &l.root && n.next != &l.root; {
+		if equal(n.Value, n.next.Value) {
+			l.Remove(n.next)
+			removed++
+		} else {
+			n = n.next
+		}
 	}
+	return removed
 }
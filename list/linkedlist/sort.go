@@ -0,0 +1,89 @@
+package linkedlist
+
+import "go-data-structure/list"
+
+// Sort sorts the nodes in place by relinking them, it is stable and takes
+// O(n log n) time and O(1) extra space. Nodes stay in the list.
+func (l *LinkedList[T]) Sort(less list.Less[T]) {
+	if l.length < 2 {
+		return
+	}
+	// bottom-up merge sort over the chain of next pointers
+	head := l.root.next
+	l.root.prev.next = nil
+	for size := 1; ; size *= 2 {
+		var tail *Node[T]
+		p, merges := head, 0
+		head = nil
+		for p != nil {
+			merges++
+			q, psize := p, 0
+			for psize < size && q != nil {
+				q = q.next
+				psize++
+			}
+			qsize := size
+			for psize > 0 || (qsize > 0 && q != nil) {
+				var e *Node[T]
+				// take from 'p' on ties to keep the sort stable
+				if psize == 0 {
+					e, q, qsize = q, q.next, qsize-1
+				} else if qsize == 0 || q == nil || !less(q.Value, p.Value) {
+					e, p, psize = p, p.next, psize-1
+				} else {
+					e, q, qsize = q, q.next, qsize-1
+				}
+				if tail == nil {
+					head = e
+				} else {
+					tail.next = e
+				}
+				tail = e
+			}
+			p = q
+		}
+		tail.next = nil
+		if merges <= 1 {
+			break
+		}
+	}
+	// restore prev pointers and the ring through root
+	prev := &l.root
+	for n := head; n != nil; n = n.next {
+		n.prev = prev
+		prev.next = n
+		prev = n
+	}
+	prev.next = &l.root
+	l.root.prev = prev
+}
+
+// IsSorted reports whether the values are sorted by less.
+func (l *LinkedList[T]) IsSorted(less list.Less[T]) bool {
+	for n := l.root.next; n != &l.root && n.next != &l.root; n = n.next {
+		if less(n.next.Value, n.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge moves the nodes of other into the list, both sorted by less, keeping
+// the list sorted. Equal values of the list stay before the ones of other,
+// other is left empty.
+func (l *LinkedList[T]) Merge(other *LinkedList[T], less list.Less[T]) {
+	if other == l {
+		return
+	}
+	n := l.root.next
+	for other.length > 0 {
+		m := other.root.next
+		if n != &l.root && !less(m.Value, n.Value) {
+			n = n.next
+			continue
+		}
+		other.unlink(m, m, 1)
+		l.link(m, m, n, 1)
+		m.owner = l.owner
+	}
+}
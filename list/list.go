@@ -9,3 +9,61 @@ type Equaler[T any] func(i, j T) bool
 
 // Less reports whether the element should sort before the other.
 type Less[T any] func(i, j T) bool
+
+// Container is implemented by every collection of the library.
+type Container interface {
+	// Len returns the number of elements.
+	Len() int
+	// Clear removes all elements.
+	Clear()
+}
+
+// Sequence is a container whose elements can be visited in order.
+type Sequence[T any] interface {
+	Container
+	// Range calls fn for each element in order until fn returns false.
+	Range(fn func(e T) bool)
+}
+
+// List is an index addressable sequence.
+type List[T any] interface {
+	Sequence[T]
+	// Get returns the element at idx, false if out of range.
+	Get(idx int) (T, bool)
+	// Set replaces the element at idx and returns the replaced one, false if out of range.
+	Set(idx int, e T) (T, bool)
+	// Insert inserts elements before idx, appends them if idx is out of range.
+	Insert(idx int, e ...T)
+	// Append appends elements to the end.
+	Append(e ...T)
+	// RemoveAt removes and returns the element at idx, false if out of range.
+	RemoveAt(idx int) (T, bool)
+}
+
+// Set is a sequence of distinct elements.
+type Set[T any] interface {
+	Sequence[T]
+	// Add inserts the element, reports false if it is already present.
+	Add(e T) bool
+	// Remove deletes the element, reports false if it is not present.
+	Remove(e T) bool
+	// Contains reports whether the element is present.
+	Contains(e T) bool
+}
+
+// SortedMap is a key-value container iterated in ascending key order.
+type SortedMap[K, V any] interface {
+	Container
+	// Put associates value with key, replacing any previous value.
+	Put(key K, value V)
+	// Get returns the value of key, false if key is not present.
+	Get(key K) (V, bool)
+	// Remove deletes key, reports false if key is not present.
+	Remove(key K) bool
+	// Min returns the entry with the smallest key, false if empty.
+	Min() (K, V, bool)
+	// Max returns the entry with the largest key, false if empty.
+	Max() (K, V, bool)
+	// Range calls fn for each entry in ascending key order until fn returns false.
+	Range(fn func(key K, value V) bool)
+}
@@ -0,0 +1,295 @@
+// Package listtest implements behavioral test suites for implementations
+// of the interfaces defined in package list.
+//
+// Every suite takes a factory returning a new empty container, drives it
+// through a fixed set of scenarios and a randomized sequence checked
+// against a reference model, so implementations can be verified against
+// the same expectations:
+//
+//	func TestArrayList(t *testing.T) {
+//		listtest.TestList(t, func() list.List[int] { return arraylist.New[int]() })
+//	}
+package listtest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list"
+)
+
+const _RANDOM_OPS = 2000
+
+// TestList runs the behavioral suite of list.List against lists produced by newList.
+func TestList(t *testing.T, newList func() list.List[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		l := newList()
+		expect(t, l, nil)
+		if _, ok := l.Get(0); ok {
+			t.Errorf("Get(0) on empty list reports present")
+		}
+		if _, ok := l.Set(0, 1); ok {
+			t.Errorf("Set(0) on empty list reports present")
+		}
+		if _, ok := l.RemoveAt(0); ok {
+			t.Errorf("RemoveAt(0) on empty list reports present")
+		}
+		expect(t, l, nil)
+	})
+
+	t.Run("Append", func(t *testing.T) {
+		l := newList()
+		l.Append()
+		expect(t, l, nil)
+		l.Append(1)
+		l.Append(2, 3, 4)
+		expect(t, l, []int{1, 2, 3, 4})
+	})
+
+	t.Run("GetSet", func(t *testing.T) {
+		l := newList()
+		l.Append(1, 2, 3)
+		for i, want := range []int{1, 2, 3} {
+			if got, ok := l.Get(i); !ok || got != want {
+				t.Errorf("Get(%d) = %d, %v, want %d, true", i, got, ok, want)
+			}
+		}
+		for _, idx := range []int{-1, 3, 100} {
+			if _, ok := l.Get(idx); ok {
+				t.Errorf("Get(%d) reports present", idx)
+			}
+			if _, ok := l.Set(idx, 0); ok {
+				t.Errorf("Set(%d) reports present", idx)
+			}
+		}
+		if old, ok := l.Set(1, 20); !ok || old != 2 {
+			t.Errorf("Set(1) = %d, %v, want 2, true", old, ok)
+		}
+		expect(t, l, []int{1, 20, 3})
+	})
+
+	t.Run("Insert", func(t *testing.T) {
+		l := newList()
+		l.Insert(0, 3)
+		l.Insert(0, 1, 2)
+		expect(t, l, []int{1, 2, 3})
+		l.Insert(1, 7, 8)
+		expect(t, l, []int{1, 7, 8, 2, 3})
+		l.Insert(4, 9)
+		expect(t, l, []int{1, 7, 8, 2, 9, 3})
+		// out of range appends
+		l.Insert(-1, 4)
+		l.Insert(l.Len(), 5)
+		l.Insert(100, 6)
+		expect(t, l, []int{1, 7, 8, 2, 9, 3, 4, 5, 6})
+	})
+
+	t.Run("RemoveAt", func(t *testing.T) {
+		l := newList()
+		l.Append(1, 2, 3, 4, 5)
+		for _, idx := range []int{-1, 5, 100} {
+			if _, ok := l.RemoveAt(idx); ok {
+				t.Errorf("RemoveAt(%d) reports present", idx)
+			}
+		}
+		expect(t, l, []int{1, 2, 3, 4, 5})
+		for _, c := range []struct{ idx, want int }{{2, 3}, {0, 1}, {2, 5}, {0, 2}, {0, 4}} {
+			if got, ok := l.RemoveAt(c.idx); !ok || got != c.want {
+				t.Errorf("RemoveAt(%d) = %d, %v, want %d, true", c.idx, got, ok, c.want)
+			}
+		}
+		expect(t, l, nil)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		l := newList()
+		l.Append(1, 2, 3)
+		l.Clear()
+		expect(t, l, nil)
+		l.Append(4)
+		expect(t, l, []int{4})
+	})
+
+	t.Run("RangeStop", func(t *testing.T) {
+		l := newList()
+		l.Append(1, 2, 3, 4)
+		var got []int
+		l.Range(func(e int) bool {
+			got = append(got, e)
+			return e < 2
+		})
+		if !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("Range stopped at %v, want [1 2]", got)
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		l := newList()
+		var ref []int
+		for i := 0; i < _RANDOM_OPS; i++ {
+			idx := r.Intn(len(ref)+2) - 1
+			switch r.Intn(6) {
+			case 0:
+				l.Append(i)
+				ref = append(ref, i)
+			case 1:
+				l.Insert(idx, i, -i)
+				if idx < 0 || idx >= len(ref) {
+					ref = append(ref, i, -i)
+				} else {
+					ref = slices.Insert(ref, idx, i, -i)
+				}
+			case 2:
+				got, ok := l.RemoveAt(idx)
+				if inRange(idx, ref) {
+					if !ok || got != ref[idx] {
+						t.Fatalf("op %d: RemoveAt(%d) = %d, %v, want %d, true", i, idx, got, ok, ref[idx])
+					}
+					ref = slices.Delete(ref, idx, idx+1)
+				} else if ok {
+					t.Fatalf("op %d: RemoveAt(%d) out of range reports present", i, idx)
+				}
+			case 3:
+				got, ok := l.Set(idx, i)
+				if inRange(idx, ref) {
+					if !ok || got != ref[idx] {
+						t.Fatalf("op %d: Set(%d) = %d, %v, want %d, true", i, idx, got, ok, ref[idx])
+					}
+					ref[idx] = i
+				} else if ok {
+					t.Fatalf("op %d: Set(%d) out of range reports present", i, idx)
+				}
+			case 4:
+				got, ok := l.Get(idx)
+				if ok != inRange(idx, ref) || ok && got != ref[idx] {
+					t.Fatalf("op %d: Get(%d) = %d, %v", i, idx, got, ok)
+				}
+			case 5:
+				if r.Intn(50) == 0 {
+					l.Clear()
+					ref = ref[:0]
+				}
+			}
+			if l.Len() != len(ref) {
+				t.Fatalf("op %d: Len() = %d, want %d", i, l.Len(), len(ref))
+			}
+		}
+		expect(t, l, ref)
+	})
+}
+
+// TestSet runs the behavioral suite of list.Set against sets produced by newSet.
+func TestSet(t *testing.T, newSet func() list.Set[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		s := newSet()
+		expectSet(t, s, nil)
+		if s.Contains(1) {
+			t.Errorf("Contains(1) on empty set reports present")
+		}
+		if s.Remove(1) {
+			t.Errorf("Remove(1) on empty set reports present")
+		}
+	})
+
+	t.Run("AddRemove", func(t *testing.T) {
+		s := newSet()
+		for _, e := range []int{3, 1, 2} {
+			if !s.Add(e) {
+				t.Errorf("Add(%d) reports present", e)
+			}
+		}
+		if s.Add(2) {
+			t.Errorf("Add(2) twice reports absent")
+		}
+		expectSet(t, s, []int{1, 2, 3})
+		if !s.Remove(2) || s.Remove(2) {
+			t.Errorf("Remove(2) should succeed once")
+		}
+		expectSet(t, s, []int{1, 3})
+		s.Clear()
+		expectSet(t, s, nil)
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		s := newSet()
+		ref := map[int]bool{}
+		for i := 0; i < _RANDOM_OPS; i++ {
+			e := r.Intn(100)
+			switch r.Intn(3) {
+			case 0:
+				if s.Add(e) == ref[e] {
+					t.Fatalf("op %d: Add(%d) disagrees with model", i, e)
+				}
+				ref[e] = true
+			case 1:
+				if s.Remove(e) != ref[e] {
+					t.Fatalf("op %d: Remove(%d) disagrees with model", i, e)
+				}
+				delete(ref, e)
+			case 2:
+				if s.Contains(e) != ref[e] {
+					t.Fatalf("op %d: Contains(%d) disagrees with model", i, e)
+				}
+			}
+			if s.Len() != len(ref) {
+				t.Fatalf("op %d: Len() = %d, want %d", i, s.Len(), len(ref))
+			}
+		}
+		var want []int
+		for e := range ref {
+			want = append(want, e)
+		}
+		expectSet(t, s, want)
+	})
+}
+
+func inRange(idx int, ref []int) bool {
+	return idx >= 0 && idx < len(ref)
+}
+
+func collect(s list.Sequence[int]) []int {
+	var es []int
+	s.Range(func(e int) bool {
+		es = append(es, e)
+		return true
+	})
+	return es
+}
+
+func expect(t *testing.T, l list.List[int], want []int) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if got := collect(l); !slices.Equal(got, want) {
+		t.Fatalf("Range visits %v, want %v", got, want)
+	}
+	for i, e := range want {
+		if got, ok := l.Get(i); !ok || got != e {
+			t.Fatalf("Get(%d) = %d, %v, want %d, true", i, got, ok, e)
+		}
+	}
+}
+
+// sets may iterate in any order, compare sorted contents
+func expectSet(t *testing.T, s list.Set[int], want []int) {
+	t.Helper()
+	if s.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", s.Len(), len(want))
+	}
+	got := collect(s)
+	slices.Sort(got)
+	want = slices.Clone(want)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Fatalf("Range visits %v, want %v", got, want)
+	}
+	for _, e := range want {
+		if !s.Contains(e) {
+			t.Fatalf("Contains(%d) reports absent", e)
+		}
+	}
+}
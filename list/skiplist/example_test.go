@@ -1,21 +1,84 @@
 package skiplist
 
-import "testing"
+import (
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/list/listtest"
+	"go-data-structure/tree/maptest"
+)
 
 func TestSkiplist(t *testing.T) {
 	sl := New[int]()
-	fn := func(i, j int) int {
-		if i < j {
-			return -1
+	for i := 0; i < 10; i++ {
+		sl.Put(i, list.Ordered[int]())
+	}
+	t.Log(sl)
+}
+
+func TestSkiplistGetRemove(t *testing.T) {
+	sl := New[int]()
+	fn := list.Ordered[int]()
+	for i := 0; i < 100; i++ {
+		sl.Put(i, fn)
+	}
+	// Get and Remove walk in the same order as Put
+	for i := 0; i < 100; i++ {
+		if n := sl.Get(i, fn); n == nil || n.Value != i {
+			t.Fatalf("Get(%d) = %v, want node of %d", i, n, i)
 		}
-		if i == j {
-			return 0
+	}
+	if n := sl.Get(100, fn); n != nil {
+		t.Fatalf("Get(100) = %v, want nil", n.Value)
+	}
+	for i := 99; i >= 0; i -= 2 {
+		if !sl.Remove(i, fn) {
+			t.Fatalf("Remove(%d) = false, want true", i)
 		}
-		return 1
+		if sl.Remove(i, fn) || sl.Get(i, fn) != nil {
+			t.Fatalf("Get(%d) after Remove found the value", i)
+		}
+	}
+	if sl.Len() != 50 {
+		t.Fatalf("Len() = %d, want 50", sl.Len())
+	}
+	for i := 0; i < 100; i += 2 {
+		sl.Remove(i, fn)
 	}
+	if sl.Len() != 0 || sl.Front() != nil || sl.Back() != nil || sl.level != 1 {
+		t.Fatalf("emptied list keeps %d values on %d levels", sl.Len(), sl.level)
+	}
+}
+
+func TestSkipSet(t *testing.T) {
+	listtest.TestSet(t, func() list.Set[int] { return NewSet(list.Ordered[int]()) })
+}
 
+func TestSkipMap(t *testing.T) {
+	maptest.TestSortedMap(t, func() list.SortedMap[int, string] { return NewMap[int, string](list.Ordered[int]()) })
+}
+
+func TestSkiplistAllocs(t *testing.T) {
+	// the list, its header node and the header levels
+	if n := testing.AllocsPerRun(100, func() { New[int]() }); n > 3 {
+		t.Errorf("New allocates %v times, want at most 3", n)
+	}
+	sl := New[int]()
+	fn := list.Ordered[int]()
+	if n := testing.AllocsPerRun(100, func() { sl.Clear() }); n != 0 {
+		t.Errorf("Clear allocates %v times, want 0", n)
+	}
+	for i := 0; i < 100; i++ {
+		sl.Put(i, fn)
+	}
+	sl.Clear()
+	if sl.Len() != 0 || sl.Front() != nil || sl.Back() != nil || sl.Get(1, fn) != nil {
+		t.Fatalf("cleared list keeps %d values", sl.Len())
+	}
 	for i := 0; i < 10; i++ {
 		sl.Put(i, fn)
 	}
-	t.Log(sl)
+	if n := sl.Get(5, fn); n == nil || sl.Len() != 10 {
+		t.Fatalf("list refilled after Clear lost values")
+	}
 }
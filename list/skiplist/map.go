@@ -0,0 +1,67 @@
+package skiplist
+
+import "go-data-structure/list"
+
+var _ list.SortedMap[int, int] = (*SkipMap[int, int])(nil)
+
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// SkipMap is a sorted map backed by a SkipList of entries ordered by key.
+type SkipMap[K, V any] struct {
+	sl      *SkipList[entry[K, V]]
+	compare func(i, j entry[K, V]) int
+}
+
+func NewMap[K, V any](compare list.Comparator[K]) *SkipMap[K, V] {
+	return &SkipMap[K, V]{
+		sl:      New[entry[K, V]](),
+		compare: func(i, j entry[K, V]) int { return compare(i.key, j.key) },
+	}
+}
+
+func (m *SkipMap[K, V]) Len() int { return m.sl.Len() }
+func (m *SkipMap[K, V]) Clear()   { m.sl.Clear() }
+
+func (m *SkipMap[K, V]) Put(key K, value V) {
+	e := entry[K, V]{key: key, value: value}
+	if n := m.sl.Get(e, m.compare); n != nil {
+		n.Value.value = value
+		return
+	}
+	m.sl.Put(e, m.compare)
+}
+
+func (m *SkipMap[K, V]) Get(key K) (value V, exist bool) {
+	n := m.sl.Get(entry[K, V]{key: key}, m.compare)
+	if n == nil {
+		return
+	}
+	return n.Value.value, true
+}
+
+func (m *SkipMap[K, V]) Remove(key K) bool {
+	return m.sl.Remove(entry[K, V]{key: key}, m.compare)
+}
+
+func (m *SkipMap[K, V]) Min() (key K, value V, exist bool) {
+	n := m.sl.Front()
+	if n == nil {
+		return
+	}
+	return n.Value.key, n.Value.value, true
+}
+
+func (m *SkipMap[K, V]) Max() (key K, value V, exist bool) {
+	n := m.sl.Back()
+	if n == nil {
+		return
+	}
+	return n.Value.key, n.Value.value, true
+}
+
+func (m *SkipMap[K, V]) Range(fn func(key K, value V) bool) {
+	m.sl.Range(func(e entry[K, V]) bool { return fn(e.key, e.value) })
+}
@@ -0,0 +1,30 @@
+package skiplist
+
+import "go-data-structure/list"
+
+var _ list.Set[int] = (*SkipSet[int])(nil)
+
+// SkipSet is a sorted set of distinct elements backed by a SkipList.
+type SkipSet[T any] struct {
+	sl      *SkipList[T]
+	compare list.Comparator[T]
+}
+
+func NewSet[T any](compare list.Comparator[T]) *SkipSet[T] {
+	return &SkipSet[T]{sl: New[T](), compare: compare}
+}
+
+func (s *SkipSet[T]) Len() int { return s.sl.Len() }
+func (s *SkipSet[T]) Clear()   { s.sl.Clear() }
+
+func (s *SkipSet[T]) Add(e T) bool {
+	if s.sl.Get(e, s.compare) != nil {
+		return false
+	}
+	s.sl.Put(e, s.compare)
+	return true
+}
+
+func (s *SkipSet[T]) Remove(e T) bool       { return s.sl.Remove(e, s.compare) }
+func (s *SkipSet[T]) Contains(e T) bool     { return s.sl.Get(e, s.compare) != nil }
+func (s *SkipSet[T]) Range(fn func(T) bool) { s.sl.Range(fn) }
@@ -4,18 +4,13 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
-	"time"
+
+	"go-data-structure/list"
 )
 
 const _MAX_LEVEL int = 32
 const _FACTOR float64 = 0.5
 
-var r *rand.Rand
-
-func init() {
-	r = rand.New(rand.NewSource(time.Now().Unix()))
-}
-
 type Node[T any] struct {
 	Value    T
 	backward *Node[T]
@@ -25,10 +20,15 @@ type Node[T any] struct {
 	}
 }
 
+var _ list.Sequence[int] = (*SkipList[int])(nil)
+
 type SkipList[T any] struct {
 	header, tail *Node[T]
 	length       int
 	level        int
+	// splitmix64 state drawing levels, every list owns its own so that
+	// lists used by different goroutines share no state
+	seed uint64
 }
 
 func New[T any]() *SkipList[T] {
@@ -46,25 +46,36 @@ func New[T any]() *SkipList[T] {
 		tail:   nil,
 		length: 0,
 		level:  1,
+		seed:   rand.Uint64(),
 	}
 }
 
-func randomLevel() int {
+// random returns a uniform float64 in [0, 1) from the splitmix64 state
+func (sl *SkipList[T]) random() float64 {
+	sl.seed += 0x9e3779b97f4a7c15
+	z := sl.seed
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return float64(z>>11) / (1 << 53)
+}
+
+func (sl *SkipList[T]) randomLevel() int {
 	level := 1
-	for r.Float64() < _FACTOR && level < _MAX_LEVEL {
+	for sl.random() < _FACTOR && level < _MAX_LEVEL {
 		level = level + 1
 	}
 	return level
 }
 
-func createNode[T any](v T) *Node[T] {
+func createNode[T any](v T, level int) *Node[T] {
 	return &Node[T]{
 		Value:    v,
 		backward: nil,
 		level: make([]struct {
 			forward *Node[T]
 			span    int
-		}, randomLevel()),
+		}, level),
 	}
 }
 
@@ -87,7 +98,7 @@ func (sl *SkipList[T]) Put(v T, compare func(i, j T) int) {
 		prev[l] = n
 	}
 
-	n = createNode(v)
+	n = createNode(v, sl.randomLevel())
 	level := len(n.level)
 	if sl.level < level {
 		for l := sl.level; l < level; l++ {
@@ -123,23 +134,29 @@ func (sl *SkipList[T]) Put(v T, compare func(i, j T) int) {
 	sl.length++
 }
 
+// Get returns the first node with value 'v', nil if not present.
 func (sl *SkipList[T]) Get(v T, compare func(i, j T) int) *Node[T] {
 	n := sl.header
 	for l := sl.level - 1; l >= 0; l-- {
-		for n.level[l].forward != nil && compare(v, n.level[l].forward.Value) < 0 {
+		for n.level[l].forward != nil && compare(n.level[l].forward.Value, v) < 0 {
 			n = n.level[l].forward
 		}
 	}
-	return n.level[0].forward
+	n = n.level[0].forward
+	if n == nil || compare(n.Value, v) != 0 {
+		return nil
+	}
+	return n
 }
 
-func (sl *SkipList[T]) Remove(v T, compare func(i, j T) int) {
+// Remove deletes the first node with value 'v', reports false if not present.
+func (sl *SkipList[T]) Remove(v T, compare func(i, j T) int) bool {
 	prev := make([]*Node[T], _MAX_LEVEL)
 
 	// find all predecessors of 'v'
 	n := sl.header
 	for l := sl.level - 1; l >= 0; l-- {
-		for n.level[l].forward != nil && compare(v, n.level[l].forward.Value) < 0 {
+		for n.level[l].forward != nil && compare(n.level[l].forward.Value, v) < 0 {
 			n = n.level[l].forward
 		}
 		prev[l] = n
@@ -148,7 +165,7 @@ func (sl *SkipList[T]) Remove(v T, compare func(i, j T) int) {
 	// node with value 'v' is presented or not
 	n = n.level[0].forward
 	if n == nil || compare(v, n.Value) != 0 {
-		return
+		return false
 	}
 
 	// update predecessors
@@ -166,10 +183,43 @@ func (sl *SkipList[T]) Remove(v T, compare func(i, j T) int) {
 		sl.tail = n.backward
 	}
 	// if remove top level node
-	if sl.level > 1 && sl.header.level[sl.level-1].forward == nil {
+	for sl.level > 1 && sl.header.level[sl.level-1].forward == nil {
+		sl.header.level[sl.level-1].span = 0
 		sl.level--
 	}
 	sl.length--
+	return true
+}
+
+func (sl *SkipList[T]) Len() int {
+	return sl.length
+}
+
+// Front returns the smallest node, nil if the list is empty.
+func (sl *SkipList[T]) Front() *Node[T] {
+	return sl.header.level[0].forward
+}
+
+// Back returns the largest node, nil if the list is empty.
+func (sl *SkipList[T]) Back() *Node[T] {
+	return sl.tail
+}
+
+// Clear removes all nodes, keeping the header and the level generator.
+func (sl *SkipList[T]) Clear() {
+	clear(sl.header.level)
+	sl.tail = nil
+	sl.length = 0
+	sl.level = 1
+}
+
+// Range calls fn for each value in ascending order until fn returns false.
+func (sl *SkipList[T]) Range(fn func(e T) bool) {
+	for n := sl.header.level[0].forward; n != nil; n = n.level[0].forward {
+		if !fn(n.Value) {
+			return
+		}
+	}
 }
 
 func (sl *SkipList[T]) String() string {
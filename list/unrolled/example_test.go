@@ -0,0 +1,167 @@
+package unrolled
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/list/arraylist"
+	"go-data-structure/list/linkedlist"
+	"go-data-structure/list/listtest"
+)
+
+func ExampleNew() {
+	l := New(1, 2, 3)
+	l.Insert(1, 7)
+	l.RemoveAt(0)
+	fmt.Println(l.Values(), l.Len())
+	// Output:
+	// [7 2 3] 3
+}
+
+func ExampleNewWith() {
+	l := NewWith[int](WithNodeCapacity(4))
+	l.Append(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	fmt.Println(l.Nodes())
+	// inserting into a full node splits it
+	l.Insert(1, 0)
+	fmt.Println(l.Nodes())
+	// Output:
+	// 3
+	// 4
+}
+
+func TestUnrolledList(t *testing.T) {
+	listtest.TestList(t, func() list.List[int] { return New[int]() })
+	listtest.TestList(t, func() list.List[int] { return NewWith[int](WithNodeCapacity(2)) })
+	listtest.TestList(t, func() list.List[int] {
+		return NewWith[int](WithNodeCapacity(5), WithMinFill(0.25))
+	})
+}
+
+// check verifies the list against want and the fill invariants of its nodes.
+func check(t *testing.T, l *UnrolledList[int], want []int) {
+	t.Helper()
+	if got := l.Values(); !slices.Equal(got, want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	nodes, length := 0, 0
+	var prev *node[int]
+	for n := l.head; n != nil; prev, n = n, n.next {
+		if n.prev != prev {
+			t.Fatalf("node %d has broken prev link", nodes)
+		}
+		if len(n.elements) == 0 || len(n.elements) > l.nodeCap {
+			t.Fatalf("node %d holds %d elements, capacity %d", nodes, len(n.elements), l.nodeCap)
+		}
+		// only a lone node may stay below the minimum fill
+		if l.nodes > 1 && len(n.elements) < l.minFill && n != l.tail {
+			t.Fatalf("node %d holds %d elements, below minimum %d", nodes, len(n.elements), l.minFill)
+		}
+		nodes++
+		length += len(n.elements)
+	}
+	if prev != l.tail || nodes != l.nodes || length != l.length {
+		t.Fatalf("list has %d nodes and %d elements, counted %d and %d", l.nodes, l.length, nodes, length)
+	}
+}
+
+func TestRebalance(t *testing.T) {
+	for _, capacity := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprint(capacity), func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(capacity)))
+			l := NewWith[int](WithNodeCapacity(capacity))
+			var want []int
+			for i := 0; i < 3000; i++ {
+				switch op := r.Intn(3); {
+				case op == 0 || len(want) < 10:
+					idx := r.Intn(len(want) + 1)
+					l.Insert(idx, i, -i)
+					want = slices.Insert(want, min(idx, len(want)), i, -i)
+				case op == 1:
+					idx := r.Intn(len(want))
+					l.RemoveAt(idx)
+					want = slices.Delete(want, idx, idx+1)
+				default:
+					l.Append(i)
+					want = append(want, i)
+				}
+				check(t, l, want)
+			}
+			for len(want) > 0 {
+				idx := r.Intn(len(want))
+				l.RemoveAt(idx)
+				want = slices.Delete(want, idx, idx+1)
+				check(t, l, want)
+			}
+			if l.Nodes() != 0 {
+				t.Fatalf("empty list keeps %d nodes", l.Nodes())
+			}
+		})
+	}
+}
+
+const _BENCH_LEN = 100000
+
+func benchLists() []struct {
+	name string
+	l    list.List[int]
+} {
+	return []struct {
+		name string
+		l    list.List[int]
+	}{
+		{"ArrayList", arraylist.New[int]()},
+		{"LinkedList", linkedlist.New[int]()},
+		{"Unrolled16", NewWith[int](WithNodeCapacity(16))},
+		{"Unrolled64", New[int]()},
+		{"Unrolled256", NewWith[int](WithNodeCapacity(256))},
+	}
+}
+
+func BenchmarkRange(b *testing.B) {
+	for _, c := range benchLists() {
+		for i := 0; i < _BENCH_LEN; i++ {
+			c.l.Append(i)
+		}
+		b.Run(c.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				c.l.Range(func(e int) bool {
+					sum += e
+					return true
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	for _, c := range benchLists() {
+		for i := 0; i < _BENCH_LEN; i++ {
+			c.l.Append(i)
+		}
+		r := rand.New(rand.NewSource(1))
+		b.Run(c.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.l.Get(r.Intn(_BENCH_LEN))
+			}
+		})
+	}
+}
+
+func BenchmarkInsertRemoveMiddle(b *testing.B) {
+	for _, c := range benchLists() {
+		for i := 0; i < _BENCH_LEN; i++ {
+			c.l.Append(i)
+		}
+		b.Run(c.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.l.Insert(_BENCH_LEN/2, i)
+				c.l.RemoveAt(_BENCH_LEN / 3)
+			}
+		})
+	}
+}
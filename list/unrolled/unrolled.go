@@ -0,0 +1,285 @@
+// Package unrolled implements an unrolled linked list, a doubly linked
+// list of nodes each holding a small array of elements.
+//
+// Nodes split in half when an insertion overflows them and merge with or
+// borrow from a neighbour when removals leave them below the minimum fill,
+// so scans touch contiguous memory while insertions and removals only
+// shift elements within one node.
+package unrolled
+
+import "go-data-structure/list"
+
+const (
+	DEFAULT_NODE_CAP = 64
+	DEFAULT_MIN_FILL = 0.5
+)
+
+var _ list.List[int] = (*UnrolledList[int])(nil)
+
+type node[T any] struct {
+	elements   []T
+	prev, next *node[T]
+}
+
+// UnrolledList represents a list of elements stored in fixed capacity nodes.
+type UnrolledList[T any] struct {
+	head, tail *node[T]
+	length     int
+	nodes      int
+	// elements per node, and the fill below which a node is rebalanced
+	nodeCap int
+	minFill int
+}
+
+type config struct {
+	nodeCap int
+	minFill float64
+}
+
+// Option configures the node layout of a list created by NewWith.
+type Option func(*config)
+
+// WithNodeCapacity sets the number of elements held by a node, at least 2.
+func WithNodeCapacity(n int) Option {
+	if n < 2 {
+		panic("unrolled: node capacity should be at least 2")
+	}
+	return func(c *config) { c.nodeCap = n }
+}
+
+// WithMinFill sets the fill ratio in range (0, 0.5] below which a node
+// merges with or borrows from a neighbour after a removal.
+func WithMinFill(ratio float64) Option {
+	if ratio <= 0 || ratio > 0.5 {
+		panic("unrolled: min fill should be in range (0, 0.5]")
+	}
+	return func(c *config) { c.minFill = ratio }
+}
+
+// New returns a list of default node capacity holding the elements.
+func New[T any](e ...T) *UnrolledList[T] {
+	l := NewWith[T]()
+	l.Append(e...)
+	return l
+}
+
+func NewWith[T any](opts ...Option) *UnrolledList[T] {
+	c := config{nodeCap: DEFAULT_NODE_CAP, minFill: DEFAULT_MIN_FILL}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &UnrolledList[T]{
+		nodeCap: c.nodeCap,
+		minFill: max(int(float64(c.nodeCap)*c.minFill), 1),
+	}
+}
+
+func (l *UnrolledList[T]) Len() int { return l.length }
+
+// Clear removes all elements and nodes.
+func (l *UnrolledList[T]) Clear() {
+	l.head, l.tail = nil, nil
+	l.length, l.nodes = 0, 0
+}
+
+// locate returns the node holding idx and the offset within it, nil if out of range.
+func (l *UnrolledList[T]) locate(idx int) (*node[T], int) {
+	if idx < 0 || idx >= l.length {
+		return nil, 0
+	}
+	if idx < l.length/2 {
+		n := l.head
+		for idx >= len(n.elements) {
+			idx -= len(n.elements)
+			n = n.next
+		}
+		return n, idx
+	}
+	n, end := l.tail, l.length
+	for idx < end-len(n.elements) {
+		end -= len(n.elements)
+		n = n.prev
+	}
+	return n, idx - (end - len(n.elements))
+}
+
+// Get returns the element at idx, false if out of range.
+func (l *UnrolledList[T]) Get(idx int) (T, bool) {
+	n, off := l.locate(idx)
+	if n == nil {
+		var e T
+		return e, false
+	}
+	return n.elements[off], true
+}
+
+// Set replaces the element at idx and returns the replaced one, false if out of range.
+func (l *UnrolledList[T]) Set(idx int, e T) (T, bool) {
+	n, off := l.locate(idx)
+	if n == nil {
+		var old T
+		return old, false
+	}
+	old := n.elements[off]
+	n.elements[off] = e
+	return old, true
+}
+
+// newNode links an empty node after 'prev', at the front if prev is nil.
+func (l *UnrolledList[T]) newNode(prev *node[T]) *node[T] {
+	n := &node[T]{elements: make([]T, 0, l.nodeCap), prev: prev}
+	if prev == nil {
+		n.next = l.head
+		l.head = n
+	} else {
+		n.next = prev.next
+		prev.next = n
+	}
+	if n.next == nil {
+		l.tail = n
+	} else {
+		n.next.prev = n
+	}
+	l.nodes++
+	return n
+}
+
+// unlinkNode removes the node from the list.
+func (l *UnrolledList[T]) unlinkNode(n *node[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	l.nodes--
+}
+
+// Append appends elements filling the last node before adding new ones.
+func (l *UnrolledList[T]) Append(e ...T) {
+	for len(e) > 0 {
+		if l.tail == nil || len(l.tail.elements) == l.nodeCap {
+			l.newNode(l.tail)
+		}
+		k := min(l.nodeCap-len(l.tail.elements), len(e))
+		l.tail.elements = append(l.tail.elements, e[:k]...)
+		l.length += k
+		e = e[k:]
+	}
+}
+
+// Insert inserts elements before idx, appends them if idx is out of range.
+func (l *UnrolledList[T]) Insert(idx int, e ...T) {
+	n, off := l.locate(idx)
+	if n == nil {
+		l.Append(e...)
+		return
+	}
+	for _, v := range e {
+		n, off = l.insertAt(n, off, v)
+		off++
+	}
+}
+
+// insertAt inserts 'v' at offset 'off' of the node, splitting it in half
+// if full, and returns the node and offset where 'v' ended up.
+func (l *UnrolledList[T]) insertAt(n *node[T], off int, v T) (*node[T], int) {
+	if len(n.elements) == l.nodeCap {
+		half := l.nodeCap / 2
+		next := l.newNode(n)
+		next.elements = append(next.elements, n.elements[half:]...)
+		clear(n.elements[half:])
+		n.elements = n.elements[:half]
+		if off > half {
+			n, off = next, off-half
+		}
+	}
+	n.elements = append(n.elements, v)
+	copy(n.elements[off+1:], n.elements[off:])
+	n.elements[off] = v
+	l.length++
+	return n, off
+}
+
+// RemoveAt removes and returns the element at idx, false if out of range.
+func (l *UnrolledList[T]) RemoveAt(idx int) (T, bool) {
+	n, off := l.locate(idx)
+	if n == nil {
+		var e T
+		return e, false
+	}
+	e := n.elements[off]
+	last := len(n.elements) - 1
+	copy(n.elements[off:], n.elements[off+1:])
+	clear(n.elements[last:])
+	n.elements = n.elements[:last]
+	l.length--
+	l.rebalance(n)
+	return e, true
+}
+
+// rebalance restores the minimum fill of a node after a removal by merging
+// it with a neighbour if both fit in one node, orelse by borrowing one
+// element from the neighbour.
+func (l *UnrolledList[T]) rebalance(n *node[T]) {
+	if len(n.elements) >= l.minFill {
+		return
+	}
+	if len(n.elements) == 0 {
+		l.unlinkNode(n)
+		return
+	}
+	switch {
+	case n.next != nil:
+		next := n.next
+		if len(n.elements)+len(next.elements) <= l.nodeCap {
+			n.elements = append(n.elements, next.elements...)
+			l.unlinkNode(next)
+		} else {
+			n.elements = append(n.elements, next.elements[0])
+			copy(next.elements, next.elements[1:])
+			clear(next.elements[len(next.elements)-1:])
+			next.elements = next.elements[:len(next.elements)-1]
+		}
+	case n.prev != nil:
+		prev := n.prev
+		if len(prev.elements)+len(n.elements) <= l.nodeCap {
+			prev.elements = append(prev.elements, n.elements...)
+			l.unlinkNode(n)
+		} else {
+			last := len(prev.elements) - 1
+			n.elements = append(n.elements, prev.elements[last])
+			copy(n.elements[1:], n.elements)
+			n.elements[0] = prev.elements[last]
+			clear(prev.elements[last:])
+			prev.elements = prev.elements[:last]
+		}
+	}
+}
+
+// Range calls fn for each element from front to back until fn returns false.
+func (l *UnrolledList[T]) Range(fn func(e T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		for _, e := range n.elements {
+			if !fn(e) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a copy of the elements.
+func (l *UnrolledList[T]) Values() []T {
+	values := make([]T, 0, l.length)
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.elements...)
+	}
+	return values
+}
+
+// Nodes returns the number of nodes, a measure of memory overhead.
+func (l *UnrolledList[T]) Nodes() int { return l.nodes }
@@ -0,0 +1,151 @@
+package vector
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func ExampleVector_Append() {
+	v1 := New(1, 2, 3)
+	v2 := v1.Append(4)
+	fmt.Println(v1.Values(), v2.Values())
+	// Output:
+	// [1 2 3] [1 2 3 4]
+}
+
+func ExampleVector_Set() {
+	v1 := New(1, 2, 3)
+	v2, _ := v1.Set(0, 9)
+	_, ok := v1.Set(3, 9)
+	fmt.Println(v1.Values(), v2.Values(), ok)
+	// Output:
+	// [1 2 3] [9 2 3] false
+}
+
+func ExampleVector_Pop() {
+	v1 := New(1, 2, 3)
+	v2, e, ok := v1.Pop()
+	fmt.Println(v2.Values(), e, ok)
+	// Output:
+	// [1 2] 3 true
+}
+
+func ExampleVector_Transient() {
+	t := New[int]().Transient()
+	for i := 0; i < 100; i++ {
+		t.Append(i)
+	}
+	t.Set(0, -1)
+	t.Pop()
+	v := t.Persistent()
+	fmt.Println(v.Len())
+	fmt.Println(v.Get(0))
+	fmt.Println(v.Get(98))
+	// Output:
+	// 99
+	// -1 true
+	// 98 true
+}
+
+func ExampleVector_Slice() {
+	v := New(1, 2, 3, 4, 5)
+	fmt.Println(v.Slice(1, 3).Concat(v.Slice(4, 10)).Values())
+	// Output:
+	// [2 3 5]
+}
+
+func TestVector(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	type version struct {
+		v   *Vector[int]
+		ref []int
+	}
+	v, ref := New[int](), []int(nil)
+	var versions []version
+	for i := 0; i < 20000; i++ {
+		switch op := r.Intn(10); {
+		case op < 5:
+			v = v.Append(i)
+			ref = append(slices.Clip(ref), i)
+		case op < 7:
+			nv, e, ok := v.Pop()
+			if ok != (len(ref) > 0) || ok && e != ref[len(ref)-1] {
+				t.Fatalf("op %d: Pop() = %d, %v", i, e, ok)
+			}
+			if ok {
+				v, ref = nv, slices.Clip(ref[:len(ref)-1])
+			}
+		case op < 9 && len(ref) > 0:
+			idx := r.Intn(len(ref))
+			v, _ = v.Set(idx, -i)
+			ref = slices.Clone(ref)
+			ref[idx] = -i
+		default:
+			// batch mutation of a transient
+			tr := v.Transient()
+			ref = slices.Clone(ref)
+			for j := 0; j < r.Intn(2000); j++ {
+				if len(ref) > 0 && r.Intn(4) == 0 {
+					e, _ := tr.Pop()
+					if e != ref[len(ref)-1] {
+						t.Fatalf("op %d: transient Pop() = %d", i, e)
+					}
+					ref = ref[:len(ref)-1]
+				} else if len(ref) > 0 && r.Intn(3) == 0 {
+					idx := r.Intn(len(ref))
+					tr.Set(idx, j)
+					ref[idx] = j
+				} else {
+					tr.Append(j)
+					ref = append(ref, j)
+				}
+			}
+			v = tr.Persistent()
+		}
+		if v.Len() != len(ref) {
+			t.Fatalf("op %d: Len() = %d, want %d", i, v.Len(), len(ref))
+		}
+		if i%100 == 0 {
+			versions = append(versions, version{v, ref})
+		}
+	}
+	// older versions stay untouched
+	for _, ver := range versions {
+		if !slices.Equal(ver.v.Values(), ver.ref) {
+			t.Fatalf("version of length %d changed", len(ver.ref))
+		}
+		for idx, e := range ver.ref {
+			if got, _ := ver.v.Get(idx); got != e {
+				t.Fatalf("Get(%d) = %d, want %d", idx, got, e)
+			}
+		}
+	}
+}
+
+func TestTransientPersistent(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("transient used after Persistent should panic")
+		}
+	}()
+	tr := New[int]().Transient()
+	tr.Persistent()
+	tr.Append(1)
+}
+
+func BenchmarkAppend(b *testing.B) {
+	b.Run("Persistent", func(b *testing.B) {
+		v := New[int]()
+		for i := 0; i < b.N; i++ {
+			v = v.Append(i)
+		}
+	})
+	b.Run("Transient", func(b *testing.B) {
+		t := New[int]().Transient()
+		for i := 0; i < b.N; i++ {
+			t.Append(i)
+		}
+	})
+}
@@ -0,0 +1,394 @@
+// Package vector implements a persistent vector, an immutable indexed
+// sequence stored in a 32-way bit-partitioned trie.
+//
+// Updates return new versions sharing all untouched nodes with the old
+// one, so copies are free and Get, Set, Append and Pop take O(log32 n),
+// effectively constant. The last up to 32 elements are kept in a tail
+// outside the trie, so Append and Pop mostly touch the tail only.
+//
+// A Transient mutates nodes it owns in place for batch updates, and
+// turns back into a Vector in O(1).
+//
+// Concatenation and slicing copy the elements, relaxed radix balanced
+// trees which make them O(log n) are not implemented.
+package vector
+
+import "go-data-structure/list"
+
+const (
+	_BITS  = 5
+	_WIDTH = 1 << _BITS
+	_MASK  = _WIDTH - 1
+)
+
+// token identifies the transient owning a node.
+type token struct{ _ byte }
+
+// node is an internal node with children or a leaf with values.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+	edit     *token
+}
+
+func (n *node[T]) clone(edit *token) *node[T] {
+	return &node[T]{
+		children: append([]*node[T](nil), n.children...),
+		values:   append([]T(nil), n.values...),
+		edit:     edit,
+	}
+}
+
+// editable returns the node itself if the transient owns it, orelse a copy.
+func (n *node[T]) editable(edit *token) *node[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	return n.clone(edit)
+}
+
+var _ list.Sequence[int] = (*Transient[int])(nil)
+
+// Vector is an immutable indexed sequence, the zero value is an empty vector.
+type Vector[T any] struct {
+	count int
+	// bits consumed by the levels above the leaves
+	shift int
+	root  *node[T]
+	tail  []T
+}
+
+func New[T any](e ...T) *Vector[T] {
+	t := (&Vector[T]{}).Transient()
+	t.Append(e...)
+	return t.Persistent()
+}
+
+func (v *Vector[T]) Len() int {
+	return v.count
+}
+
+// index of the first element in the tail
+func (v *Vector[T]) tailoff() int {
+	if v.count < _WIDTH {
+		return 0
+	}
+	return (v.count - 1) >> _BITS << _BITS
+}
+
+// leaf returns the values of the leaf holding idx.
+func (v *Vector[T]) leaf(idx int) []T {
+	if idx >= v.tailoff() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= _BITS {
+		n = n.children[(idx>>level)&_MASK]
+	}
+	return n.values
+}
+
+// Get returns the element at idx, false if out of range.
+func (v *Vector[T]) Get(idx int) (T, bool) {
+	var e T
+	if idx < 0 || idx >= v.count {
+		return e, false
+	}
+	return v.leaf(idx)[idx&_MASK], true
+}
+
+// Set returns a version with the element at idx replaced, false and the
+// vector itself if out of range.
+func (v *Vector[T]) Set(idx int, e T) (*Vector[T], bool) {
+	if idx < 0 || idx >= v.count {
+		return v, false
+	}
+	nv := *v
+	nv.set(nil, idx, e)
+	return &nv, true
+}
+
+// Append returns a version with elements appended.
+func (v *Vector[T]) Append(e ...T) *Vector[T] {
+	if len(e) > _WIDTH {
+		t := v.Transient()
+		t.Append(e...)
+		return t.Persistent()
+	}
+	nv := *v
+	for _, el := range e {
+		nv.push(nil, el)
+	}
+	return &nv
+}
+
+// Pop returns a version without the last element and the removed element,
+// false and the vector itself if empty.
+func (v *Vector[T]) Pop() (*Vector[T], T, bool) {
+	var e T
+	if v.count == 0 {
+		return v, e, false
+	}
+	nv := *v
+	e = nv.pop(nil)
+	return &nv, e, true
+}
+
+// Range calls fn for each element in order until fn returns false.
+func (v *Vector[T]) Range(fn func(e T) bool) {
+	for idx := 0; idx < v.count; idx += _WIDTH {
+		for _, e := range v.leaf(idx) {
+			if !fn(e) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a copy of the elements.
+func (v *Vector[T]) Values() []T {
+	es := make([]T, 0, v.count)
+	v.Range(func(e T) bool {
+		es = append(es, e)
+		return true
+	})
+	return es
+}
+
+// Concat returns a version with elements of 'other' appended, in O(other.Len()).
+func (v *Vector[T]) Concat(other *Vector[T]) *Vector[T] {
+	t := v.Transient()
+	other.Range(func(e T) bool {
+		t.Append(e)
+		return true
+	})
+	return t.Persistent()
+}
+
+// Slice returns a vector of elements in range [from, to), the range is
+// clamped, in O(to-from).
+func (v *Vector[T]) Slice(from, to int) *Vector[T] {
+	from, to = max(from, 0), min(to, v.count)
+	t := (&Vector[T]{}).Transient()
+	for idx := from; idx < to; idx++ {
+		e, _ := v.Get(idx)
+		t.Append(e)
+	}
+	return t.Persistent()
+}
+
+// Transient returns a mutable copy of the vector in O(1).
+func (v *Vector[T]) Transient() *Transient[T] {
+	t := &Transient[T]{v: *v, edit: &token{}}
+	t.v.tail = append(make([]T, 0, _WIDTH), v.tail...)
+	return t
+}
+
+func (v *Vector[T]) set(edit *token, idx int, e T) {
+	if idx >= v.tailoff() {
+		if edit == nil {
+			v.tail = append([]T(nil), v.tail...)
+		}
+		v.tail[idx&_MASK] = e
+		return
+	}
+	v.root = v.root.set(edit, v.shift, idx, e)
+}
+
+func (n *node[T]) set(edit *token, level, idx int, e T) *node[T] {
+	n = n.editable(edit)
+	if level == 0 {
+		n.values[idx&_MASK] = e
+	} else {
+		sub := (idx >> level) & _MASK
+		n.children[sub] = n.children[sub].set(edit, level-_BITS, idx, e)
+	}
+	return n
+}
+
+func (v *Vector[T]) push(edit *token, e T) {
+	// room in tail
+	if v.count-v.tailoff() < _WIDTH {
+		if edit == nil {
+			v.tail = append(append(make([]T, 0, len(v.tail)+1), v.tail...), e)
+		} else {
+			v.tail = append(v.tail, e)
+		}
+		v.count++
+		return
+	}
+	// full tail moves into the trie
+	leaf := &node[T]{values: v.tail, edit: edit}
+	if v.root == nil {
+		v.root = &node[T]{children: make([]*node[T], _WIDTH), edit: edit}
+		v.shift = _BITS
+	}
+	if v.count>>_BITS > 1<<v.shift {
+		// root overflow, grow one level
+		root := &node[T]{children: make([]*node[T], _WIDTH), edit: edit}
+		root.children[0] = v.root
+		root.children[1] = path(edit, v.shift, leaf)
+		v.root = root
+		v.shift += _BITS
+	} else {
+		v.root = v.root.pushTail(edit, v.count, v.shift, leaf)
+	}
+	if edit == nil {
+		v.tail = []T{e}
+	} else {
+		v.tail = append(make([]T, 0, _WIDTH), e)
+	}
+	v.count++
+}
+
+// path returns a chain of single-child nodes from 'level' down to the leaf.
+func path[T any](edit *token, level int, leaf *node[T]) *node[T] {
+	if level == 0 {
+		return leaf
+	}
+	n := &node[T]{children: make([]*node[T], _WIDTH), edit: edit}
+	n.children[0] = path(edit, level-_BITS, leaf)
+	return n
+}
+
+func (n *node[T]) pushTail(edit *token, count, level int, leaf *node[T]) *node[T] {
+	n = n.editable(edit)
+	sub := ((count - 1) >> level) & _MASK
+	if level == _BITS {
+		n.children[sub] = leaf
+	} else if child := n.children[sub]; child != nil {
+		n.children[sub] = child.pushTail(edit, count, level-_BITS, leaf)
+	} else {
+		n.children[sub] = path(edit, level-_BITS, leaf)
+	}
+	return n
+}
+
+func (v *Vector[T]) pop(edit *token) T {
+	e := v.tail[len(v.tail)-1]
+	if v.count == 1 {
+		*v = Vector[T]{}
+		return e
+	}
+	// shrink tail
+	if v.count-v.tailoff() > 1 {
+		var zero T
+		if edit == nil {
+			v.tail = append([]T(nil), v.tail[:len(v.tail)-1]...)
+		} else {
+			v.tail[len(v.tail)-1] = zero
+			v.tail = v.tail[:len(v.tail)-1]
+		}
+		v.count--
+		return e
+	}
+	// last leaf of the trie becomes the tail
+	tail := v.leaf(v.count - 2)
+	if edit == nil {
+		v.tail = append([]T(nil), tail...)
+	} else {
+		v.tail = append(make([]T, 0, _WIDTH), tail...)
+	}
+	v.root = v.root.popTail(edit, v.count, v.shift)
+	if v.root == nil {
+		v.root = &node[T]{children: make([]*node[T], _WIDTH), edit: edit}
+	} else if v.shift > _BITS && v.root.children[1] == nil {
+		// root with a single child, drop one level
+		v.root = v.root.children[0]
+		v.shift -= _BITS
+	}
+	v.count--
+	return e
+}
+
+func (n *node[T]) popTail(edit *token, count, level int) *node[T] {
+	sub := ((count - 2) >> level) & _MASK
+	if level > _BITS {
+		child := n.children[sub].popTail(edit, count, level-_BITS)
+		if child == nil && sub == 0 {
+			return nil
+		}
+		n = n.editable(edit)
+		n.children[sub] = child
+		return n
+	}
+	if sub == 0 {
+		return nil
+	}
+	n = n.editable(edit)
+	n.children[sub] = nil
+	return n
+}
+
+// Transient is a mutable vector for batch updates, it must not be used
+// after Persistent.
+type Transient[T any] struct {
+	v    Vector[T]
+	edit *token
+}
+
+func (t *Transient[T]) ensure() {
+	if t.edit == nil {
+		panic("vector: transient used after Persistent")
+	}
+}
+
+func (t *Transient[T]) Len() int {
+	return t.v.count
+}
+
+// Get returns the element at idx, false if out of range.
+func (t *Transient[T]) Get(idx int) (T, bool) {
+	t.ensure()
+	return t.v.Get(idx)
+}
+
+// Set replaces the element at idx in place, false if out of range.
+func (t *Transient[T]) Set(idx int, e T) bool {
+	t.ensure()
+	if idx < 0 || idx >= t.v.count {
+		return false
+	}
+	t.v.set(t.edit, idx, e)
+	return true
+}
+
+// Append appends elements in place.
+func (t *Transient[T]) Append(e ...T) {
+	t.ensure()
+	for _, el := range e {
+		t.v.push(t.edit, el)
+	}
+}
+
+// Pop removes and returns the last element in place, false if empty.
+func (t *Transient[T]) Pop() (T, bool) {
+	t.ensure()
+	var e T
+	if t.v.count == 0 {
+		return e, false
+	}
+	return t.v.pop(t.edit), true
+}
+
+// Clear removes all elements.
+func (t *Transient[T]) Clear() {
+	t.ensure()
+	t.v = Vector[T]{tail: make([]T, 0, _WIDTH)}
+}
+
+// Range calls fn for each element in order until fn returns false.
+func (t *Transient[T]) Range(fn func(e T) bool) {
+	t.ensure()
+	t.v.Range(fn)
+}
+
+// Persistent returns the immutable vector of the elements in O(1) and
+// invalidates the transient.
+func (t *Transient[T]) Persistent() *Vector[T] {
+	t.ensure()
+	t.edit = nil
+	v := t.v
+	v.tail = v.tail[:len(v.tail):len(v.tail)]
+	return &v
+}
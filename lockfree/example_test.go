@@ -0,0 +1,300 @@
+package lockfree
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func ExampleMPMC() {
+	q := NewMPMC[string](3)
+	fmt.Println(q.Cap())
+	var enqueued []bool
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		enqueued = append(enqueued, q.TryEnqueue(v))
+	}
+	fmt.Println(enqueued)
+	v, ok := q.TryDequeue()
+	fmt.Println(v, ok, q.Len())
+	// Output:
+	// 4
+	// [true true true true false]
+	// a true 3
+}
+
+func ExampleSPSC_TryEnqueueBatch() {
+	r := NewSPSC[int](4)
+	fmt.Println(r.TryEnqueueBatch([]int{1, 2, 3, 4, 5, 6}))
+	dst := make([]int, 3)
+	n := r.TryDequeueBatch(dst)
+	fmt.Println(dst[:n], r.Len())
+	// Output:
+	// 4
+	// [1 2 3] 1
+}
+
+// stressed is the interface shared by the queues for the stress tests.
+type stressed interface {
+	TryEnqueue(v int) bool
+	TryDequeue() (int, bool)
+}
+
+// stress runs producers each enqueueing 'items' distinct values and as
+// many consumers, and checks every value is dequeued exactly once.
+func stress(t *testing.T, q stressed, producers, consumers, items int) {
+	total := producers * items
+	seen := make([]atomic.Int32, total)
+	var dequeued atomic.Int64
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				for !q.TryEnqueue(p*items + i) {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// values of one producer arrive in order
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			for dequeued.Load() < int64(total) {
+				v, ok := q.TryDequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				dequeued.Add(1)
+				seen[v].Add(1)
+				p, i := v/items, v%items
+				if i <= last[p] {
+					t.Errorf("value %d of producer %d after %d", i, p, last[p])
+				}
+				last[p] = i
+			}
+		}()
+	}
+	wg.Wait()
+	for v := range seen {
+		if n := seen[v].Load(); n != 1 {
+			t.Fatalf("value %d dequeued %d times", v, n)
+		}
+	}
+	if _, ok := q.TryDequeue(); ok {
+		t.Fatalf("queue not empty after all values were dequeued")
+	}
+}
+
+func TestMPMC(t *testing.T) {
+	for _, capacity := range []int{1, 2, 64} {
+		t.Run(fmt.Sprint(capacity), func(t *testing.T) {
+			stress(t, NewMPMC[int](capacity), 4, 4, 5000)
+		})
+	}
+}
+
+func TestMPMCBatch(t *testing.T) {
+	q := NewMPMC[int](8)
+	if n := q.TryEnqueueBatch([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}); n != 8 {
+		t.Fatalf("TryEnqueueBatch() = %d, want 8", n)
+	}
+	dst := make([]int, 5)
+	for round, want := range []int{5, 3, 0} {
+		if n := q.TryDequeueBatch(dst); n != want {
+			t.Fatalf("round %d: TryDequeueBatch() = %d, want %d", round, n, want)
+		}
+	}
+}
+
+func TestSPSC(t *testing.T) {
+	for _, capacity := range []int{1, 3, 64} {
+		t.Run(fmt.Sprint(capacity), func(t *testing.T) {
+			stress(t, NewSPSC[int](capacity), 1, 1, 20000)
+		})
+	}
+}
+
+func TestSPSCBatch(t *testing.T) {
+	const items = 20000
+	r := NewSPSC[int](16)
+	go func() {
+		batch := make([]int, 0, 7)
+		for i := 0; i < items; {
+			batch = batch[:0]
+			for j := i; j < min(i+7, items); j++ {
+				batch = append(batch, j)
+			}
+			i += r.TryEnqueueBatch(batch)
+			runtime.Gosched()
+		}
+	}()
+	dst := make([]int, 5)
+	for next := 0; next < items; {
+		n := r.TryDequeueBatch(dst)
+		for _, v := range dst[:n] {
+			if v != next {
+				t.Fatalf("dequeued %d, want %d", v, next)
+			}
+			next++
+		}
+		if n == 0 {
+			runtime.Gosched()
+		}
+	}
+}
+
+const _BENCH_ITEMS = 1 << 16
+
+// benchmark moves values from producers to consumers through send and receive.
+func benchmark(b *testing.B, producers, consumers int, send func(int), receive func()) {
+	for i := 0; i < b.N; i++ {
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < _BENCH_ITEMS/producers; j++ {
+					send(j)
+				}
+			}()
+		}
+		for c := 0; c < consumers; c++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < _BENCH_ITEMS/consumers; j++ {
+					receive()
+				}
+			}()
+		}
+		wg.Wait()
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*_BENCH_ITEMS), "ns/item")
+}
+
+func spinEnqueue(q stressed) func(int) {
+	return func(v int) {
+		for !q.TryEnqueue(v) {
+			runtime.Gosched()
+		}
+	}
+}
+
+func spinDequeue(q stressed) func() {
+	return func() {
+		for {
+			if _, ok := q.TryDequeue(); ok {
+				return
+			}
+			runtime.Gosched()
+		}
+	}
+}
+
+func BenchmarkMPMC(b *testing.B) {
+	for _, n := range []int{1, 4} {
+		b.Run(fmt.Sprintf("Queue%dx%d", n, n), func(b *testing.B) {
+			q := NewMPMC[int](1024)
+			benchmark(b, n, n, spinEnqueue(q), spinDequeue(q))
+		})
+		b.Run(fmt.Sprintf("Chan%dx%d", n, n), func(b *testing.B) {
+			ch := make(chan int, 1024)
+			benchmark(b, n, n, func(v int) { ch <- v }, func() { <-ch })
+		})
+	}
+}
+
+func BenchmarkSPSC(b *testing.B) {
+	b.Run("Ring", func(b *testing.B) {
+		r := NewSPSC[int](1024)
+		benchmark(b, 1, 1, spinEnqueue(r), spinDequeue(r))
+	})
+	b.Run("Chan", func(b *testing.B) {
+		ch := make(chan int, 1024)
+		benchmark(b, 1, 1, func(v int) { ch <- v }, func() { <-ch })
+	})
+}
+
+func ExampleStack() {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	fmt.Println(s.TryPop())
+	fmt.Println(s.Peek())
+	fmt.Println(s.Len())
+	// Output:
+	// 2 true
+	// 1 true
+	// 1
+}
+
+func TestQueue(t *testing.T) {
+	stress(t, NewQueue[int](), 8, 8, 5000)
+	q := NewQueue[int]()
+	if q.Len() != 0 {
+		t.Fatalf("Len() = %d on empty queue", q.Len())
+	}
+}
+
+func TestStack(t *testing.T) {
+	const goroutines, items = 8, 5000
+	s := NewStack[int]()
+	seen := make([]atomic.Int32, goroutines*items)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			// pushes and pops interleave so that the top changes hands constantly
+			for i := 0; i < items; i++ {
+				s.Push(g*items + i)
+				if i%2 == 1 {
+					if v, ok := s.TryPop(); ok {
+						seen[v].Add(1)
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if s.Len() != goroutines*items/2 {
+		t.Fatalf("Len() = %d, want %d", s.Len(), goroutines*items/2)
+	}
+	for {
+		v, ok := s.TryPop()
+		if !ok {
+			break
+		}
+		seen[v].Add(1)
+	}
+	for v := range seen {
+		if n := seen[v].Load(); n != 1 {
+			t.Fatalf("value %d popped %d times", v, n)
+		}
+	}
+}
+
+func BenchmarkQueue(b *testing.B) {
+	q := NewQueue[int]()
+	benchmark(b, 4, 4, spinEnqueue(q), spinDequeue(q))
+}
+
+func BenchmarkStack(b *testing.B) {
+	s := NewStack[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.TryPop()
+		}
+	})
+}
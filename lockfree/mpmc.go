@@ -0,0 +1,144 @@
+// Package lockfree implements queues and stacks for passing values between
+// goroutines without locks.
+//
+// Operations never block: TryEnqueue reports false on a full bounded queue
+// and TryDequeue or TryPop on an empty container, callers decide whether
+// to spin, yield or fall back to another path.
+package lockfree
+
+import "sync/atomic"
+
+// _CACHE_LINE separates fields written by different goroutines to avoid
+// false sharing.
+const _CACHE_LINE = 64
+
+type pad [_CACHE_LINE]byte
+
+type cell[T any] struct {
+	// seq equals the position of the next enqueue into the cell when it is
+	// free, that position plus one once it holds a value
+	seq   atomic.Uint64
+	value T
+}
+
+// MPMC is a bounded multi-producer multi-consumer queue, a ring of cells
+// tagged with sequence numbers after Dmitry Vyukov's design. Producers and
+// consumers claim positions with one compare-and-swap each.
+type MPMC[T any] struct {
+	_     pad
+	enq   atomic.Uint64
+	_     pad
+	deq   atomic.Uint64
+	_     pad
+	mask  uint64
+	cells []cell[T]
+}
+
+// NewMPMC returns a queue holding at least 'capacity' values, rounded up
+// to a power of two.
+func NewMPMC[T any](capacity int) *MPMC[T] {
+	if capacity < 1 {
+		panic("lockfree: capacity should be positive")
+	}
+	size := roundUp(max(capacity, 2))
+	q := &MPMC[T]{mask: uint64(size - 1), cells: make([]cell[T], size)}
+	for i := range q.cells {
+		q.cells[i].seq.Store(uint64(i))
+	}
+	return q
+}
+
+// roundUp returns the smallest power of two not less than n.
+func roundUp(n int) int {
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+	return size
+}
+
+func (q *MPMC[T]) Cap() int { return len(q.cells) }
+
+// Len returns the number of values, approximate while other goroutines
+// operate on the queue.
+func (q *MPMC[T]) Len() int {
+	deq := q.deq.Load()
+	enq := q.enq.Load()
+	if enq < deq {
+		return 0
+	}
+	return min(int(enq-deq), len(q.cells))
+}
+
+// TryEnqueue reports whether 'v' was enqueued, false if the queue is full.
+func (q *MPMC[T]) TryEnqueue(v T) bool {
+	pos := q.enq.Load()
+	for {
+		c := &q.cells[pos&q.mask]
+		seq := c.seq.Load()
+		switch dif := int64(seq - pos); {
+		case dif == 0:
+			if q.enq.CompareAndSwap(pos, pos+1) {
+				c.value = v
+				c.seq.Store(pos + 1)
+				return true
+			}
+			pos = q.enq.Load()
+		case dif < 0:
+			// the cell still holds the value enqueued a lap ago
+			return false
+		default:
+			pos = q.enq.Load()
+		}
+	}
+}
+
+// TryDequeue returns the oldest value, false if the queue is empty.
+func (q *MPMC[T]) TryDequeue() (T, bool) {
+	pos := q.deq.Load()
+	for {
+		c := &q.cells[pos&q.mask]
+		seq := c.seq.Load()
+		switch dif := int64(seq - (pos + 1)); {
+		case dif == 0:
+			if q.deq.CompareAndSwap(pos, pos+1) {
+				v := c.value
+				var zero T
+				c.value = zero
+				c.seq.Store(pos + q.mask + 1)
+				return v, true
+			}
+			pos = q.deq.Load()
+		case dif < 0:
+			var zero T
+			return zero, false
+		default:
+			pos = q.deq.Load()
+		}
+	}
+}
+
+// TryEnqueueBatch enqueues values in order until the queue is full and
+// returns how many were enqueued. Values of concurrent producers may
+// interleave with the batch.
+func (q *MPMC[T]) TryEnqueueBatch(vs []T) int {
+	for i, v := range vs {
+		if !q.TryEnqueue(v) {
+			return i
+		}
+	}
+	return len(vs)
+}
+
+// TryDequeueBatch dequeues values into dst until it is full or the queue
+// is empty and returns how many were dequeued.
+func (q *MPMC[T]) TryDequeueBatch(dst []T) int {
+	for i := range dst {
+		v, ok := q.TryDequeue()
+		if !ok {
+			return i
+		}
+		dst[i] = v
+	}
+	return len(dst)
+}
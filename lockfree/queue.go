@@ -0,0 +1,92 @@
+package lockfree
+
+import "sync/atomic"
+
+type node[T any] struct {
+	value T
+	next  atomic.Pointer[node[T]]
+}
+
+// Queue is an unbounded multi-producer multi-consumer queue after Michael
+// and Scott. Garbage collection rules out the ABA problem of the original,
+// nodes are never reused.
+type Queue[T any] struct {
+	_ pad
+	// head points to a dummy node, the value follows it
+	head atomic.Pointer[node[T]]
+	_    pad
+	tail atomic.Pointer[node[T]]
+	_    pad
+	len  atomic.Int64
+}
+
+func NewQueue[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Len returns the number of values, approximate while other goroutines
+// operate on the queue.
+func (q *Queue[T]) Len() int {
+	return max(int(q.len.Load()), 0)
+}
+
+// Enqueue appends 'v' to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	n := &node[T]{value: v}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// help a lagging producer swing the tail
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.len.Add(1)
+			return
+		}
+	}
+}
+
+// TryDequeue returns the value at the front, false if the queue is empty.
+//
+// The dequeued node becomes the new dummy and keeps its value reachable
+// until the next dequeue, clearing it would race with concurrent readers.
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		v := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.len.Add(-1)
+			return v, true
+		}
+	}
+}
+
+// TryEnqueue always enqueues 'v' and reports true, so that the queue can
+// stand in for the bounded ones.
+func (q *Queue[T]) TryEnqueue(v T) bool {
+	q.Enqueue(v)
+	return true
+}
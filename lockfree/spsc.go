@@ -0,0 +1,119 @@
+package lockfree
+
+import "sync/atomic"
+
+// SPSC is a bounded ring for exactly one producer and one consumer
+// goroutine. Each side owns its index and keeps a cached copy of the
+// other's, reading the shared one only when the cache says the ring is
+// full or empty.
+type SPSC[T any] struct {
+	_ pad
+	// written by the consumer
+	head       atomic.Uint64
+	cachedTail uint64
+	_          pad
+	// written by the producer
+	tail       atomic.Uint64
+	cachedHead uint64
+	_          pad
+	mask       uint64
+	buf        []T
+}
+
+// NewSPSC returns a ring holding at least 'capacity' values, rounded up to
+// a power of two.
+func NewSPSC[T any](capacity int) *SPSC[T] {
+	if capacity < 1 {
+		panic("lockfree: capacity should be positive")
+	}
+	size := roundUp(capacity)
+	return &SPSC[T]{mask: uint64(size - 1), buf: make([]T, size)}
+}
+
+func (r *SPSC[T]) Cap() int { return len(r.buf) }
+
+// Len returns the number of values, approximate while the other side operates on the ring.
+func (r *SPSC[T]) Len() int {
+	head := r.head.Load()
+	tail := r.tail.Load()
+	if tail < head {
+		return 0
+	}
+	return int(tail - head)
+}
+
+// free returns the number of free slots seen by the producer at 'tail'.
+func (r *SPSC[T]) free(tail uint64) int {
+	if n := len(r.buf) - int(tail-r.cachedHead); n > 0 {
+		return n
+	}
+	r.cachedHead = r.head.Load()
+	return len(r.buf) - int(tail-r.cachedHead)
+}
+
+// used returns the number of values seen by the consumer at 'head'.
+func (r *SPSC[T]) used(head uint64) int {
+	if n := int(r.cachedTail - head); n > 0 {
+		return n
+	}
+	r.cachedTail = r.tail.Load()
+	return int(r.cachedTail - head)
+}
+
+// TryEnqueue reports whether 'v' was enqueued, false if the ring is full.
+// It must only be called by the producer.
+func (r *SPSC[T]) TryEnqueue(v T) bool {
+	tail := r.tail.Load()
+	if r.free(tail) == 0 {
+		return false
+	}
+	r.buf[tail&r.mask] = v
+	r.tail.Store(tail + 1)
+	return true
+}
+
+// TryDequeue returns the oldest value, false if the ring is empty. It must
+// only be called by the consumer.
+func (r *SPSC[T]) TryDequeue() (T, bool) {
+	var zero T
+	head := r.head.Load()
+	if r.used(head) == 0 {
+		return zero, false
+	}
+	i := head & r.mask
+	v := r.buf[i]
+	r.buf[i] = zero
+	r.head.Store(head + 1)
+	return v, true
+}
+
+// TryEnqueueBatch enqueues as many values as fit and returns how many, the
+// consumer sees them all at once. It must only be called by the producer.
+func (r *SPSC[T]) TryEnqueueBatch(vs []T) int {
+	tail := r.tail.Load()
+	n := min(r.free(tail), len(vs))
+	for i := 0; i < n; i++ {
+		r.buf[(tail+uint64(i))&r.mask] = vs[i]
+	}
+	if n > 0 {
+		r.tail.Store(tail + uint64(n))
+	}
+	return n
+}
+
+// TryDequeueBatch dequeues values into dst until it is full or the ring is
+// empty and returns how many. It must only be called by the consumer.
+func (r *SPSC[T]) TryDequeueBatch(dst []T) int {
+	var zero T
+	head := r.head.Load()
+	n := min(r.used(head), len(dst))
+	for i := 0; i < n; i++ {
+		j := (head + uint64(i)) & r.mask
+		dst[i] = r.buf[j]
+		r.buf[j] = zero
+	}
+	if n > 0 {
+		r.head.Store(head + uint64(n))
+	}
+	return n
+}
@@ -0,0 +1,62 @@
+package lockfree
+
+import "sync/atomic"
+
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// Stack is an unbounded multi-producer multi-consumer stack after Treiber,
+// the top is swapped with a single compare-and-swap per operation.
+type Stack[T any] struct {
+	top atomic.Pointer[stackNode[T]]
+	_   pad
+	len atomic.Int64
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Len returns the number of values, approximate while other goroutines
+// operate on the stack.
+func (s *Stack[T]) Len() int {
+	return max(int(s.len.Load()), 0)
+}
+
+// Push puts 'v' on top of the stack.
+func (s *Stack[T]) Push(v T) {
+	n := &stackNode[T]{value: v}
+	for {
+		n.next = s.top.Load()
+		if s.top.CompareAndSwap(n.next, n) {
+			s.len.Add(1)
+			return
+		}
+	}
+}
+
+// TryPop removes and returns the top value, false if the stack is empty.
+func (s *Stack[T]) TryPop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, false
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.len.Add(-1)
+			return top.value, true
+		}
+	}
+}
+
+// Peek returns the top value, false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if top := s.top.Load(); top != nil {
+		return top.value, true
+	}
+	var zero T
+	return zero, false
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"go-data-structure/constraints"
+	"go-data-structure/list"
 )
 
 const (
@@ -13,8 +14,11 @@ const (
 	RIGHT
 )
 
+var _ list.SortedMap[int, int] = (*AvlTree[int, int])(nil)
+
 type AvlTree[K constraints.Ordered, V any] struct {
 	root *Node[K, V]
+	size int
 }
 
 func New[K constraints.Ordered, V any]() *AvlTree[K, V] {
@@ -22,7 +26,10 @@ func New[K constraints.Ordered, V any]() *AvlTree[K, V] {
 }
 
 func (t *AvlTree[K, V]) Put(key K, value V) {
-	t.root = t.root.put(key, value, t.root)
+	var added bool
+	if t.root, added = t.root.put(key, value, t.root); added {
+		t.size++
+	}
 }
 
 func (t *AvlTree[K, V]) Get(key K) (value V, exist bool) {
@@ -33,8 +40,13 @@ func (t *AvlTree[K, V]) Get(key K) (value V, exist bool) {
 	return n.value, true
 }
 
-func (t *AvlTree[K, V]) Remove(key K) {
-	t.root = t.root.remove(key)
+// Remove deletes key, reports false if key is not present.
+func (t *AvlTree[K, V]) Remove(key K) bool {
+	var removed bool
+	if t.root, removed = t.root.remove(key); removed {
+		t.size--
+	}
+	return removed
 }
 
 func (t *AvlTree[K, V]) Height() int {
@@ -42,7 +54,43 @@ func (t *AvlTree[K, V]) Height() int {
 }
 
 func (t *AvlTree[K, V]) Size() int {
-	return t.root.size()
+	return t.size
+}
+
+func (t *AvlTree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *AvlTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+func (t *AvlTree[K, V]) Min() (key K, value V, exist bool) {
+	n := t.root
+	if n == nil {
+		return
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+func (t *AvlTree[K, V]) Max() (key K, value V, exist bool) {
+	n := t.root
+	if n == nil {
+		return
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Range calls fn for each entry in ascending key order until fn returns false.
+func (t *AvlTree[K, V]) Range(fn func(key K, value V) bool) {
+	t.root.walk(fn)
 }
 
 func (t *AvlTree[K, V]) String() string {
@@ -104,7 +152,8 @@ type Node[K constraints.Ordered, V any] struct {
 	left, right *Node[K, V]
 }
 
-func (n *Node[K, V]) put(key K, value V, parent *Node[K, V]) *Node[K, V] {
+// put reports false if key is present and only its value is replaced
+func (n *Node[K, V]) put(key K, value V, parent *Node[K, V]) (*Node[K, V], bool) {
 	if n == nil {
 		return &Node[K, V]{
 			key:    key,
@@ -113,16 +162,17 @@ func (n *Node[K, V]) put(key K, value V, parent *Node[K, V]) *Node[K, V] {
 			parent: parent,
 			left:   nil,
 			right:  nil,
-		}
+		}, true
 	}
+	var added bool
 	if key < n.key {
-		n.left = n.left.put(key, value, n)
+		n.left, added = n.left.put(key, value, n)
 	} else if key > n.key {
-		n.right = n.right.put(key, value, n)
+		n.right, added = n.right.put(key, value, n)
 	} else {
 		n.value = value
 	}
-	return n.rebalance()
+	return n.rebalance(), added
 }
 
 func (n *Node[K, V]) get(key K) *Node[K, V] {
@@ -138,14 +188,16 @@ func (n *Node[K, V]) get(key K) *Node[K, V] {
 
 }
 
-func (n *Node[K, V]) remove(key K) *Node[K, V] {
+// remove reports whether key is found and removed
+func (n *Node[K, V]) remove(key K) (*Node[K, V], bool) {
 	if n == nil {
-		return nil
+		return nil, false
 	}
+	removed := true
 	if key < n.key {
-		n.left = n.left.remove(key)
+		n.left, removed = n.left.remove(key)
 	} else if key > n.key {
-		n.right = n.right.remove(key)
+		n.right, removed = n.right.remove(key)
 	} else {
 		if n.left == nil && n.right == nil { // case 1: no child
 			n = nil
@@ -176,10 +228,10 @@ func (n *Node[K, V]) remove(key K) *Node[K, V] {
 			}
 			n.key = successor.key
 			n.value = successor.value
-			n.right = n.right.remove(successor.key)
+			n.right, _ = n.right.remove(successor.key)
 		}
 	}
-	return n.rebalance()
+	return n.rebalance(), removed
 }
 
 func (n *Node[K, V]) rebalance() *Node[K, V] {
@@ -291,11 +343,12 @@ func (n *Node[K, V]) rotate(lr int) (root *Node[K, V]) {
 	return
 }
 
-func (n *Node[K, V]) size() int {
+// in-order traversal, returns false once fn stops it
+func (n *Node[K, V]) walk(fn func(key K, value V) bool) bool {
 	if n == nil {
-		return 0
+		return true
 	}
-	return 1 + n.left.size() + n.right.size()
+	return n.left.walk(fn) && fn(n.key, n.value) && n.right.walk(fn)
 }
 
 func (n *Node[K, V]) String() string {
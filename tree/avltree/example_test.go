@@ -2,6 +2,9 @@ package avltree
 
 import (
 	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/tree/maptest"
 )
 
 func TestAvltree(t *testing.T) {
@@ -11,3 +14,25 @@ func TestAvltree(t *testing.T) {
 	}
 	t.Log(tree)
 }
+
+func TestAvltreeRemove(t *testing.T) {
+	tree := New[int, int]()
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+	}
+	for i := 0; i < 100; i += 2 {
+		if !tree.Remove(i) {
+			t.Fatalf("Remove(%d) = false, want true", i)
+		}
+		if tree.Remove(i) {
+			t.Fatalf("Remove(%d) of a removed key = true", i)
+		}
+	}
+	if tree.Remove(100) || tree.Size() != 50 {
+		t.Fatalf("Size() = %d after removals, want 50", tree.Size())
+	}
+}
+
+func TestAvlTreeSortedMap(t *testing.T) {
+	maptest.TestSortedMap(t, func() list.SortedMap[int, string] { return New[int, string]() })
+}
@@ -1,6 +1,9 @@
 package btree
 
-import "go-data-structure/constraints"
+import (
+	"go-data-structure/constraints"
+	"go-data-structure/list"
+)
 
 type Entry[K constraints.Ordered, V any] struct {
 	key   K
@@ -30,6 +33,38 @@ func (n *Node[K, V]) writeAt(entry *Entry[K, V], off int) {
 	n.entries[off] = entry
 }
 
+func (n *Node[K, V]) removeAt(off int) *Entry[K, V] {
+	entry := n.entries[off]
+	copy(n.entries[off:], n.entries[off+1:])
+	n.entries[len(n.entries)-1] = nil
+	n.entries = n.entries[:len(n.entries)-1]
+	return entry
+}
+
+func (n *Node[K, V]) removeChildAt(off int) *Node[K, V] {
+	child := n.children[off]
+	copy(n.children[off:], n.children[off+1:])
+	n.children[len(n.children)-1] = nil
+	n.children = n.children[:len(n.children)-1]
+	return child
+}
+
+// in-order traversal, returns false once fn stops it
+func (n *Node[K, V]) walk(fn func(key K, value V) bool) bool {
+	for i, entry := range n.entries {
+		if !n.isLeaf() && !n.children[i].walk(fn) {
+			return false
+		}
+		if !fn(entry.key, entry.value) {
+			return false
+		}
+	}
+	if !n.isLeaf() {
+		return n.children[len(n.children)-1].walk(fn)
+	}
+	return true
+}
+
 // find out index of entries where to append or continue to search children
 func (n *Node[K, V]) binarySearch(key K) (int, bool) {
 	left, right := 0, len(n.entries)-1
@@ -46,6 +81,9 @@ func (n *Node[K, V]) binarySearch(key K) (int, bool) {
 	return left, false
 }
 
+var _ list.SortedMap[int, int] = (*BTree[int, int])(nil)
+
+// BTree is a B-Tree of order 'm', every node holds at most m-1 entries and m children.
 type BTree[K constraints.Ordered, V any] struct {
 	root   *Node[K, V]
 	height int
@@ -68,14 +106,69 @@ func (t *BTree[K, V]) Size() int {
 	return t.size
 }
 
+func (t *BTree[K, V]) Len() int {
+	return t.size
+}
+
 func (t *BTree[K, V]) Height() int {
 	return t.height
 }
 
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.height = 0
+	t.size = 0
+}
+
+func (t *BTree[K, V]) Get(key K) (value V, exist bool) {
+	for n := t.root; n != nil; {
+		index, ok := n.binarySearch(key)
+		if ok {
+			return n.entries[index].value, true
+		}
+		if n.isLeaf() {
+			return
+		}
+		n = n.children[index]
+	}
+	return
+}
+
+func (t *BTree[K, V]) Min() (key K, value V, exist bool) {
+	if t.root == nil {
+		return
+	}
+	n := t.root
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+	return n.entries[0].key, n.entries[0].value, true
+}
+
+func (t *BTree[K, V]) Max() (key K, value V, exist bool) {
+	if t.root == nil {
+		return
+	}
+	n := t.root
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+	entry := n.entries[len(n.entries)-1]
+	return entry.key, entry.value, true
+}
+
+// Range calls fn for each entry in ascending key order until fn returns false.
+func (t *BTree[K, V]) Range(fn func(key K, value V) bool) {
+	if t.root != nil {
+		t.root.walk(fn)
+	}
+}
+
 func (t *BTree[K, V]) Put(key K, value V) {
+	entry := &Entry[K, V]{key: key, value: value}
 	if t.root == nil {
 		t.root = &Node[K, V]{
-			entries:  []*Entry[K, V](nil),
+			entries:  []*Entry[K, V]{entry},
 			children: []*Node[K, V](nil),
 		}
 		t.size++
@@ -83,7 +176,7 @@ func (t *BTree[K, V]) Put(key K, value V) {
 		return
 	}
 
-	mid, right := t.put(t.root, &Entry[K, V]{key: key, value: value})
+	mid, right := t.put(t.root, entry)
 	if mid != nil {
 		root := &Node[K, V]{
 			entries:  []*Entry[K, V]{mid},
@@ -96,12 +189,13 @@ func (t *BTree[K, V]) Put(key K, value V) {
 
 func (t *BTree[K, V]) put(n *Node[K, V], entry *Entry[K, V]) (*Entry[K, V], *Node[K, V]) {
 	index, ok := n.binarySearch(entry.key)
+	if ok {
+		// key presents, replace value in place
+		n.entries[index].value = entry.value
+		return nil, nil
+	}
 	if n.isLeaf() {
 		// leaf node, insert entry
-		if ok {
-			n.entries[index] = entry
-			return nil, nil
-		}
 		n.writeAt(entry, index)
 		t.size++
 	} else {
@@ -125,18 +219,103 @@ func (t *BTree[K, V]) split(n *Node[K, V]) (*Entry[K, V], *Node[K, V]) {
 		return nil, nil
 	}
 	// split left / middle / right parts
-	mid := (t.m - 1) / 2
+	mid := len(n.entries) / 2
 	right := &Node[K, V]{
-		children: []*Node[K, V]{nil},
-		entries:  append([]*Entry[K, V]{nil}, n.entries[mid+1:]...),
+		entries: append([]*Entry[K, V](nil), n.entries[mid+1:]...),
 	}
 	middle := n.entries[mid]
-	n.entries = append([]*Entry[K, V]{nil}, n.entries[:mid]...)
+	n.entries = append([]*Entry[K, V](nil), n.entries[:mid]...)
 
 	// if node is internal, split children also
 	if !n.isLeaf() {
-		n.children = append([]*Node[K, V](nil), n.children[:mid+1]...)
 		right.children = append([]*Node[K, V](nil), n.children[mid+1:]...)
+		n.children = append([]*Node[K, V](nil), n.children[:mid+1]...)
 	}
 	return middle, right
 }
+
+// Remove deletes key, reports false if key is not present.
+func (t *BTree[K, V]) Remove(key K) bool {
+	if t.root == nil || !t.remove(t.root, key) {
+		return false
+	}
+	t.size--
+	// shrink height if root runs out of entries
+	if len(t.root.entries) == 0 {
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+		t.height--
+	}
+	return true
+}
+
+func (t *BTree[K, V]) remove(n *Node[K, V], key K) bool {
+	index, ok := n.binarySearch(key)
+	if n.isLeaf() {
+		if ok {
+			n.removeAt(index)
+		}
+		return ok
+	}
+	if ok {
+		// internal node, replace entry by its predecessor, the largest one
+		// of left subtree, then remove the predecessor from the leaf
+		predecessor := n.children[index]
+		for !predecessor.isLeaf() {
+			predecessor = predecessor.children[len(predecessor.children)-1]
+		}
+		n.entries[index] = predecessor.entries[len(predecessor.entries)-1]
+		key = n.entries[index].key
+	}
+	if !t.remove(n.children[index], key) {
+		return false
+	}
+	if len(n.children[index].entries) < t.minEntries() {
+		t.rebalance(n, index)
+	}
+	return true
+}
+
+// minimum entries of a non-root node
+func (t *BTree[K, V]) minEntries() int {
+	return (t.m+1)/2 - 1
+}
+
+// fix up underflow of child at 'index' by borrowing from a sibling
+// or merging with it
+func (t *BTree[K, V]) rebalance(n *Node[K, V], index int) {
+	child := n.children[index]
+	if index > 0 && len(n.children[index-1].entries) > t.minEntries() {
+		// rotate right: parent entry moves down, left sibling's last entry moves up
+		left := n.children[index-1]
+		child.writeAt(n.entries[index-1], 0)
+		n.entries[index-1] = left.removeAt(len(left.entries) - 1)
+		if !left.isLeaf() {
+			moved := left.removeChildAt(len(left.children) - 1)
+			child.children = append([]*Node[K, V]{moved}, child.children...)
+		}
+		return
+	}
+	if index < len(n.children)-1 && len(n.children[index+1].entries) > t.minEntries() {
+		// rotate left: parent entry moves down, right sibling's first entry moves up
+		right := n.children[index+1]
+		child.entries = append(child.entries, n.entries[index])
+		n.entries[index] = right.removeAt(0)
+		if !right.isLeaf() {
+			child.children = append(child.children, right.removeChildAt(0))
+		}
+		return
+	}
+	// merge child with a sibling and the separating parent entry
+	if index > 0 {
+		index--
+	}
+	left, right := n.children[index], n.children[index+1]
+	left.entries = append(left.entries, n.removeAt(index))
+	left.entries = append(left.entries, right.entries...)
+	left.children = append(left.children, right.children...)
+	n.removeChildAt(index + 1)
+}
@@ -0,0 +1,108 @@
+package btree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"go-data-structure/list"
+	"go-data-structure/tree/maptest"
+)
+
+// check verifies the order, fill and depth of every node below n and
+// returns the depth of its leaves.
+func check(t *testing.T, tree *BTree[int, int], n *Node[int, int], root bool) int {
+	t.Helper()
+	if len(n.entries) > tree.m-1 || len(n.entries) == 0 || !root && len(n.entries) < (tree.m+1)/2-1 {
+		t.Fatalf("node holds %d entries, order %d", len(n.entries), tree.m)
+	}
+	for i, entry := range n.entries {
+		if entry == nil || i > 0 && n.entries[i-1].key >= entry.key {
+			t.Fatalf("node entries out of order")
+		}
+	}
+	if n.isLeaf() {
+		return 1
+	}
+	if len(n.children) != len(n.entries)+1 {
+		t.Fatalf("node has %d children for %d entries", len(n.children), len(n.entries))
+	}
+	depth := check(t, tree, n.children[0], false)
+	for _, child := range n.children[1:] {
+		if check(t, tree, child, false) != depth {
+			t.Fatalf("leaves at different depths")
+		}
+	}
+	return depth + 1
+}
+
+func TestBTreePut(t *testing.T) {
+	for _, m := range []int{3, 4, 5, 16} {
+		t.Run(fmt.Sprintf("M=%d", m), func(t *testing.T) {
+			tree := New[int, int](m)
+			keys := rand.New(rand.NewSource(int64(m))).Perm(500)
+			for _, k := range keys {
+				tree.Put(k, k)
+			}
+			if depth := check(t, tree, tree.root, true); depth != tree.Height() {
+				t.Fatalf("Height() = %d, leaves at depth %d", tree.Height(), depth)
+			}
+			// replace values of keys in leaves and inner nodes alike
+			for _, k := range keys {
+				tree.Put(k, -k)
+			}
+			if tree.Size() != len(keys) {
+				t.Fatalf("Size() = %d, want %d", tree.Size(), len(keys))
+			}
+			for _, k := range keys {
+				if v, ok := tree.Get(k); !ok || v != -k {
+					t.Fatalf("Get(%d) = %d, %v, want %d", k, v, ok, -k)
+				}
+			}
+			next := 0
+			tree.Range(func(k, v int) bool {
+				if k != next {
+					t.Fatalf("Range visited %d, want %d", k, next)
+				}
+				next++
+				return true
+			})
+		})
+	}
+}
+
+func TestBTreeRemove(t *testing.T) {
+	for _, m := range []int{3, 4, 5, 16} {
+		t.Run(fmt.Sprintf("M=%d", m), func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(m)))
+			tree := New[int, int](m)
+			for _, k := range r.Perm(500) {
+				tree.Put(k, k)
+			}
+			for i, k := range r.Perm(500) {
+				if !tree.Remove(k) || tree.Remove(k) {
+					t.Fatalf("Remove(%d) did not report a single removal", k)
+				}
+				if _, ok := tree.Get(k); ok || tree.Size() != 499-i {
+					t.Fatalf("Size() = %d after removing %d, want %d", tree.Size(), k, 499-i)
+				}
+				if tree.root != nil {
+					if depth := check(t, tree, tree.root, true); depth != tree.Height() {
+						t.Fatalf("Height() = %d, leaves at depth %d", tree.Height(), depth)
+					}
+				}
+			}
+			if tree.root != nil || tree.Height() != 0 {
+				t.Fatalf("emptied tree keeps height %d", tree.Height())
+			}
+		})
+	}
+}
+
+func TestBTree(t *testing.T) {
+	for _, m := range []int{3, 4, 5, 16} {
+		t.Run(fmt.Sprintf("M=%d", m), func(t *testing.T) {
+			maptest.TestSortedMap(t, func() list.SortedMap[int, string] { return New[int, string](m) })
+		})
+	}
+}
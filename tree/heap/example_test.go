@@ -0,0 +1,586 @@
+package heap
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list"
+)
+
+func drain[T any](q *PriorityQueue[T]) []T {
+	var es []T
+	for q.Len() > 0 {
+		e, _ := q.Pop()
+		es = append(es, e)
+	}
+	return es
+}
+
+func ExampleNewMinHeap() {
+	q := NewMinHeap(5, 1, 4, 2, 3)
+	fmt.Println(drain(q))
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func ExampleNewMaxHeap() {
+	q := NewMaxHeap("b", "c", "a")
+	fmt.Println(q.Peek())
+	// Output:
+	// c true
+}
+
+func ExampleNew() {
+	type task struct {
+		name     string
+		priority int
+	}
+	q := New(list.By(func(t task) int { return t.priority }).Less(),
+		task{"write", 2}, task{"read", 1}, task{"sleep", 9})
+	q.Push(task{"eat", 0})
+	for q.Len() > 0 {
+		t, _ := q.Pop()
+		fmt.Println(t.name)
+	}
+	// Output:
+	// eat
+	// read
+	// write
+	// sleep
+}
+
+func ExamplePriorityQueue_PushPop() {
+	q := NewMinHeap(3, 5)
+	// 1 would be on top, it is returned at once
+	fmt.Println(q.PushPop(1))
+	fmt.Println(q.PushPop(4))
+	fmt.Println(drain(q))
+	// Output:
+	// 1
+	// 3
+	// [4 5]
+}
+
+func ExamplePriorityQueue_Replace() {
+	q := NewMinHeap(3, 5)
+	fmt.Println(q.Replace(9))
+	fmt.Println(drain(q))
+	// Output:
+	// 3 true
+	// [5 9]
+}
+
+func TestPriorityQueue(t *testing.T) {
+	for _, arity := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprint(arity), func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(arity)))
+			initial := r.Perm(100)
+			q := NewDary(arity, list.Ordered[int]().Less(), initial...)
+			if q.Arity() != arity {
+				t.Fatalf("Arity() = %d, want %d", q.Arity(), arity)
+			}
+			// the reference is kept sorted
+			ref := slices.Clone(initial)
+			slices.Sort(ref)
+			for i := 0; i < 5000; i++ {
+				v := r.Intn(1000)
+				switch r.Intn(5) {
+				case 0, 1:
+					q.Push(v)
+					ref = insertSorted(ref, v)
+				case 2:
+					got, ok := q.Pop()
+					if ok != (len(ref) > 0) || ok && got != ref[0] {
+						t.Fatalf("Pop() = %d, %v, want minimum of %v", got, ok, ref)
+					}
+					if ok {
+						ref = ref[1:]
+					}
+				case 3:
+					want := v
+					if len(ref) > 0 && ref[0] < v {
+						want = ref[0]
+						ref = insertSorted(ref[1:], v)
+					}
+					if got := q.PushPop(v); got != want {
+						t.Fatalf("PushPop(%d) = %d, want %d", v, got, want)
+					}
+				case 4:
+					got, ok := q.Replace(v)
+					if ok != (len(ref) > 0) || ok && got != ref[0] {
+						t.Fatalf("Replace(%d) = %d, %v, want minimum of %v", v, got, ok, ref)
+					}
+					if ok {
+						ref = ref[1:]
+					}
+					ref = insertSorted(ref, v)
+				}
+				if q.Len() != len(ref) {
+					t.Fatalf("Len() = %d, want %d", q.Len(), len(ref))
+				}
+				if top, ok := q.Peek(); ok != (len(ref) > 0) || ok && top != ref[0] {
+					t.Fatalf("Peek() = %d, %v, want minimum of %v", top, ok, ref)
+				}
+			}
+			if got := drain(q); !slices.Equal(got, ref) {
+				t.Fatalf("drained %v, want %v", got, ref)
+			}
+			q.Clear()
+			if _, ok := q.Pop(); ok || q.Len() != 0 {
+				t.Fatalf("cleared queue not empty")
+			}
+		})
+	}
+}
+
+func insertSorted(s []int, v int) []int {
+	i, _ := slices.BinarySearch(s, v)
+	return slices.Insert(s, i, v)
+}
+
+func TestFromSlice(t *testing.T) {
+	s := rand.New(rand.NewSource(1)).Perm(1000)
+	q := FromSlice(4, list.Ordered[int]().Less(), s)
+	if got := drain(q); !slices.IsSorted(got) || len(got) != 1000 {
+		t.Fatalf("drained unsorted values")
+	}
+}
+
+func TestFromSliceSmall(t *testing.T) {
+	for _, arity := range []int{2, 3, 4} {
+		t.Run(fmt.Sprint(arity), func(t *testing.T) {
+			less := list.Ordered[int]().Less()
+			if q := NewDary(arity, less); q.Len() != 0 {
+				t.Fatalf("empty heap has %d elements", q.Len())
+			}
+			q := NewDary(arity, less, 7)
+			if got := drain(q); !slices.Equal(got, []int{7}) {
+				t.Fatalf("drained %v, want [7]", got)
+			}
+		})
+	}
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	const n = 100000
+	for _, arity := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("Arity%d", arity), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			q := NewDary(arity, list.Ordered[int]().Less(), r.Perm(n)...)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q.Push(r.Intn(n))
+				q.Pop()
+			}
+		})
+	}
+}
+
+func BenchmarkHeapify(b *testing.B) {
+	s := rand.New(rand.NewSource(1)).Perm(100000)
+	less := list.Ordered[int]().Less()
+	b.Run("New", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			New(less, s...)
+		}
+	})
+	b.Run("Push", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := New(less)
+			for _, v := range s {
+				q.Push(v)
+			}
+		}
+	})
+}
+
+func ExamplePriorityQueue_Update() {
+	q := NewMinHeap[int]()
+	a := q.Push(5)
+	b := q.Push(7)
+	q.Push(6)
+	q.Update(b, 1)
+	q.Remove(a)
+	fmt.Println(q.Contains(a), b.Value())
+	for q.Len() > 0 {
+		fmt.Println(q.Pop())
+	}
+	// Output:
+	// false 1
+	// 1 true
+	// 6 true
+}
+
+func ExampleKeyedQueue() {
+	// distances of a Dijkstra search
+	q := NewKeyed[string](list.Ordered[int]().Less())
+	q.Put("a", 4)
+	q.Put("b", 2)
+	q.Put("a", 1)
+	fmt.Println(q.Get("a"))
+	fmt.Println(q.Pop())
+	fmt.Println(q.Contains("a"), q.Len())
+	// Output:
+	// 1 true
+	// a 1 true
+	// false 1
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	for _, arity := range []int{2, 3} {
+		t.Run(fmt.Sprint(arity), func(t *testing.T) {
+			testHandles(t, NewDary(arity, list.Ordered[int]().Less()))
+		})
+	}
+}
+
+func testHandles(t *testing.T, q *PriorityQueue[int]) {
+	r := rand.New(rand.NewSource(1))
+	var handles []*Handle[int]
+	ref := map[*Handle[int]]int{}
+	minimum := func() int {
+		m := -1
+		for _, v := range ref {
+			if m < 0 || v < m {
+				m = v
+			}
+		}
+		return m
+	}
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(1000)
+		var h *Handle[int]
+		if len(handles) > 0 {
+			h = handles[r.Intn(len(handles))]
+		}
+		_, in := ref[h]
+		switch r.Intn(5) {
+		case 0, 1:
+			h = q.Push(v)
+			handles = append(handles, h)
+			ref[h] = v
+		case 2:
+			if q.Update(h, v) != in {
+				t.Fatalf("Update() = %v, want %v", !in, in)
+			}
+			if in {
+				ref[h] = v
+			}
+		case 3:
+			if q.Remove(h) != in {
+				t.Fatalf("Remove() = %v, want %v", !in, in)
+			}
+			delete(ref, h)
+		case 4:
+			want := minimum()
+			got, ok := q.Pop()
+			if ok != (want >= 0) || ok && got != want {
+				t.Fatalf("Pop() = %d, %v, want %d", got, ok, want)
+			}
+			// the popped handle is any with the minimum value
+			for h, v := range ref {
+				if v == got && !q.Contains(h) {
+					delete(ref, h)
+					break
+				}
+			}
+		}
+		if q.Len() != len(ref) {
+			t.Fatalf("Len() = %d, want %d", q.Len(), len(ref))
+		}
+		for _, h := range handles {
+			_, in := ref[h]
+			if q.Contains(h) != in {
+				t.Fatalf("Contains() = %v, want %v", !in, in)
+			}
+		}
+		for i, h := range q.handles {
+			if h.index != i {
+				t.Fatalf("handle at %d has index %d", i, h.index)
+			}
+		}
+	}
+}
+
+func TestKeyedQueue(t *testing.T) {
+	q := NewKeyed[int](list.Ordered[int]().Reverse().Less())
+	for i := 0; i < 100; i++ {
+		q.Put(i%10, i)
+	}
+	if !q.Remove(3) || q.Remove(3) || q.Update(3, 0) {
+		t.Fatalf("removed key still queued")
+	}
+	var keys []int
+	for q.Len() > 0 {
+		k, v, _ := q.Pop()
+		if v != 90+k {
+			t.Fatalf("key %d popped with %d, want %d", k, v, 90+k)
+		}
+		keys = append(keys, k)
+	}
+	if want := []int{9, 8, 7, 6, 5, 4, 2, 1, 0}; !slices.Equal(keys, want) {
+		t.Fatalf("popped keys %v, want %v", keys, want)
+	}
+}
+
+func ExampleHeap() {
+	var h Heap[int, *PairingNode[int]] = NewPairing(list.Ordered[int]().Less())
+	other := NewPairing(list.Ordered[int]().Less())
+	h.Push(5)
+	n := other.Push(9)
+	other.Push(7)
+	h.Meld(other)
+	// handles stay valid across melds
+	h.DecreaseKey(n, 1)
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		fmt.Print(v, " ")
+	}
+	fmt.Println(other.Len())
+	// Output:
+	// 1 5 7 0
+}
+
+// testHeap drives heaps produced by newHeap through random operations
+// checked against a reference multiset.
+func testHeap[H comparable](t *testing.T, newHeap func() Heap[int, H]) {
+	r := rand.New(rand.NewSource(1))
+	h, other := newHeap(), newHeap()
+	var handles []H
+	// values of the handles in h, and in other
+	ref, otherRef := map[H]int{}, map[H]int{}
+	minimum := func() (int, bool) {
+		m, ok := 0, false
+		for _, v := range ref {
+			if !ok || v < m {
+				m, ok = v, true
+			}
+		}
+		return m, ok
+	}
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(1000)
+		var hd H
+		if len(handles) > 0 {
+			hd = handles[r.Intn(len(handles))]
+		}
+		switch r.Intn(8) {
+		case 0, 1, 2:
+			hd = h.Push(v)
+			handles = append(handles, hd)
+			ref[hd] = v
+		case 3:
+			hd = other.Push(v)
+			handles = append(handles, hd)
+			otherRef[hd] = v
+		case 4:
+			old, in := ref[hd]
+			want := in && v <= old
+			if got := h.DecreaseKey(hd, v); got != want {
+				t.Fatalf("DecreaseKey(%d) of %d = %v, want %v", v, old, got, want)
+			}
+			if want {
+				ref[hd] = v
+			}
+		case 5, 6:
+			want, ok := minimum()
+			got, popped := h.Pop()
+			if popped != ok || ok && got != want {
+				t.Fatalf("Pop() = %d, %v, want %d, %v", got, popped, want, ok)
+			}
+			for hd, v := range ref {
+				if v == got && !h.Contains(hd) {
+					delete(ref, hd)
+					break
+				}
+			}
+		case 7:
+			if r.Intn(4) == 0 {
+				h.Meld(other)
+				for hd, v := range otherRef {
+					ref[hd] = v
+				}
+				clear(otherRef)
+			}
+		}
+		if h.Len() != len(ref) || other.Len() != len(otherRef) {
+			t.Fatalf("Len() = %d and %d, want %d and %d", h.Len(), other.Len(), len(ref), len(otherRef))
+		}
+		if want, ok := minimum(); ok {
+			if got, _ := h.Peek(); got != want {
+				t.Fatalf("Peek() = %d, want %d", got, want)
+			}
+		}
+		for _, hd := range handles {
+			if _, in := ref[hd]; h.Contains(hd) != in {
+				t.Fatalf("Contains() = %v, want %v", !in, in)
+			}
+			if _, in := otherRef[hd]; other.Contains(hd) != in {
+				t.Fatalf("other Contains() = %v, want %v", !in, in)
+			}
+		}
+	}
+
+	h.Clear()
+	if _, ok := h.Pop(); ok || h.Len() != 0 {
+		t.Fatalf("cleared heap not empty")
+	}
+	for _, hd := range handles {
+		if h.Contains(hd) {
+			t.Fatalf("cleared heap contains a handle")
+		}
+	}
+}
+
+func TestHeaps(t *testing.T) {
+	less := list.Ordered[int]().Less()
+	t.Run("Binary", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *Handle[int]] { return New(less) })
+	})
+	t.Run("Dary4", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *Handle[int]] { return NewDary(4, less) })
+	})
+	t.Run("Pairing", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *PairingNode[int]] { return NewPairing(less) })
+	})
+	t.Run("Fibonacci", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *FibNode[int]] { return NewFibonacci(less) })
+	})
+	t.Run("Leftist", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *LeftistNode[int]] { return NewLeftist(less) })
+	})
+	t.Run("Skew", func(t *testing.T) {
+		testHeap(t, func() Heap[int, *LeftistNode[int]] { return NewSkew(less) })
+	})
+}
+
+// wrapped hides the concrete type of a heap
+type wrapped struct {
+	Heap[int, *PairingNode[int]]
+}
+
+func TestMeldOtherType(t *testing.T) {
+	less := list.Ordered[int]().Less()
+	h, other := NewPairing(less), NewPairing(less)
+	other.Push(1)
+	if h.Meld(wrapped{other}) || h.Len() != 0 || other.Len() != 1 {
+		t.Fatalf("Meld of another heap type moved elements")
+	}
+	if !h.Meld(other) || h.Len() != 1 || other.Len() != 0 {
+		t.Fatalf("Meld of the same heap type did not move elements")
+	}
+}
+
+func TestPriorityQueueMeld(t *testing.T) {
+	less := list.Ordered[int]().Less()
+	q := NewDary(3, less, 5, 1, 9)
+	other := New(less, 4, 8, 0)
+	q.Meld(other)
+	if got := drain(q); !slices.Equal(got, []int{0, 1, 4, 5, 8, 9}) || other.Len() != 0 {
+		t.Fatalf("melded queue drained %v, other has %d elements", got, other.Len())
+	}
+}
+
+type edge struct{ to, weight int }
+
+type vertex struct{ id, dist int }
+
+// randomGraph returns the adjacency lists of a connected directed graph.
+func randomGraph(n, degree int, seed int64) [][]edge {
+	r := rand.New(rand.NewSource(seed))
+	g := make([][]edge, n)
+	for v := range g {
+		// a ring keeps every vertex reachable
+		g[v] = append(g[v], edge{(v + 1) % n, 1 + r.Intn(100)})
+		for i := 1; i < degree; i++ {
+			g[v] = append(g[v], edge{r.Intn(n), 1 + r.Intn(100)})
+		}
+	}
+	return g
+}
+
+// dijkstra returns the distances from vertex 0.
+func dijkstra[H any](g [][]edge, h Heap[vertex, H]) []int {
+	dist := make([]int, len(g))
+	for i := range dist {
+		dist[i] = -1
+	}
+	handles := make([]H, len(g))
+	queued := make([]bool, len(g))
+	dist[0] = 0
+	handles[0], queued[0] = h.Push(vertex{0, 0}), true
+	for {
+		u, ok := h.Pop()
+		if !ok {
+			return dist
+		}
+		for _, e := range g[u.id] {
+			d := u.dist + e.weight
+			switch {
+			case !queued[e.to]:
+				dist[e.to] = d
+				handles[e.to], queued[e.to] = h.Push(vertex{e.to, d}), true
+			case d < dist[e.to] && h.Contains(handles[e.to]):
+				dist[e.to] = d
+				h.DecreaseKey(handles[e.to], vertex{e.to, d})
+			}
+		}
+	}
+}
+
+var byDist = list.By(func(v vertex) int { return v.dist }).Less()
+
+func TestDijkstra(t *testing.T) {
+	g := randomGraph(2000, 5, 1)
+	want := dijkstra(g, New(byDist))
+	for name, got := range map[string][]int{
+		"Dary4":     dijkstra(g, NewDary(4, byDist)),
+		"Pairing":   dijkstra(g, NewPairing(byDist)),
+		"Fibonacci": dijkstra(g, NewFibonacci(byDist)),
+		"Leftist":   dijkstra(g, NewLeftist(byDist)),
+		"Skew":      dijkstra(g, NewSkew(byDist)),
+	} {
+		if !slices.Equal(got, want) {
+			t.Errorf("%s distances differ from the binary heap", name)
+		}
+	}
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	for _, size := range []struct{ n, degree int }{{10000, 4}, {10000, 32}} {
+		g := randomGraph(size.n, size.degree, 1)
+		prefix := fmt.Sprintf("V%dE%d/", size.n, size.n*size.degree)
+		b.Run(prefix+"Binary", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, New(byDist))
+			}
+		})
+		b.Run(prefix+"Dary4", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, NewDary(4, byDist))
+			}
+		})
+		b.Run(prefix+"Pairing", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, NewPairing(byDist))
+			}
+		})
+		b.Run(prefix+"Fibonacci", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, NewFibonacci(byDist))
+			}
+		})
+		b.Run(prefix+"Leftist", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, NewLeftist(byDist))
+			}
+		})
+		b.Run(prefix+"Skew", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(g, NewSkew(byDist))
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package heap
+
+import (
+	"go-data-structure/internal/owner"
+	"go-data-structure/list"
+)
+
+var _ Heap[int, *FibNode[int]] = (*FibonacciHeap[int])(nil)
+
+// FibNode is the handle of an element of a FibonacciHeap.
+type FibNode[T any] struct {
+	value T
+	// siblings form a circular list
+	parent, child, left, right *FibNode[T]
+	degree                     int
+	// a child was cut since the node became a child itself
+	mark  bool
+	owner *owner.Owner[FibonacciHeap[T]]
+}
+
+// Value returns the element.
+func (n *FibNode[T]) Value() T { return n.value }
+
+// FibonacciHeap is a lazy heap of trees with O(1) Push and Meld, amortized
+// O(1) DecreaseKey and amortized O(log n) Pop.
+type FibonacciHeap[T any] struct {
+	min    *FibNode[T]
+	length int
+	less   list.Less[T]
+	owner  *owner.Owner[FibonacciHeap[T]]
+	// scratch space of Pop
+	roots, degrees []*FibNode[T]
+}
+
+func NewFibonacci[T any](less list.Less[T]) *FibonacciHeap[T] {
+	h := &FibonacciHeap[T]{less: less}
+	h.owner = owner.New(h)
+	return h
+}
+
+func (h *FibonacciHeap[T]) Len() int { return h.length }
+
+// Clear removes all elements, their handles are no longer contained.
+func (h *FibonacciHeap[T]) Clear() {
+	h.min, h.length, h.owner = nil, 0, h.owner.Release()
+}
+
+func (h *FibonacciHeap[T]) Contains(n *FibNode[T]) bool {
+	if n == nil {
+		return false
+	}
+	n.owner = owner.Resolve(n.owner)
+	return n.owner.Container() == h
+}
+
+// concat joins the circular lists of a and b.
+func concat[T any](a, b *FibNode[T]) {
+	a.right.left = b.left
+	b.left.right = a.right
+	a.right = b
+	b.left = a
+}
+
+// detach removes n from its circular list, leaving it a list of its own.
+func detach[T any](n *FibNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+// addRoot adds the detached tree n to the root list.
+func (h *FibonacciHeap[T]) addRoot(n *FibNode[T]) {
+	n.parent = nil
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	concat(h.min, n)
+	if h.less(n.value, h.min.value) {
+		h.min = n
+	}
+}
+
+func (h *FibonacciHeap[T]) Push(e T) *FibNode[T] {
+	n := &FibNode[T]{value: e, owner: h.owner}
+	n.left, n.right = n, n
+	h.addRoot(n)
+	h.length++
+	return n
+}
+
+func (h *FibonacciHeap[T]) Peek() (T, bool) {
+	if h.min == nil {
+		var e T
+		return e, false
+	}
+	return h.min.value, true
+}
+
+func (h *FibonacciHeap[T]) Pop() (T, bool) {
+	z := h.min
+	if z == nil {
+		var e T
+		return e, false
+	}
+	// the children of the minimum become roots
+	if c := z.child; c != nil {
+		for n := c; ; n = n.right {
+			n.parent = nil
+			if n.right == c {
+				break
+			}
+		}
+		concat(z, c)
+		z.child = nil
+	}
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		detach(z)
+		h.consolidate()
+	}
+	h.length--
+	z.owner = nil
+	return z.value, true
+}
+
+// consolidate links roots of equal degree until all degrees differ and
+// finds the new minimum.
+func (h *FibonacciHeap[T]) consolidate() {
+	h.roots = h.roots[:0]
+	for n := h.min; ; n = n.right {
+		h.roots = append(h.roots, n)
+		if n.right == h.min {
+			break
+		}
+	}
+	degrees := h.degrees[:0]
+	for _, x := range h.roots {
+		d := x.degree
+		for d < len(degrees) && degrees[d] != nil {
+			y := degrees[d]
+			if h.less(y.value, x.value) {
+				x, y = y, x
+			}
+			// y becomes a child of x
+			detach(y)
+			y.parent, y.mark = x, false
+			if x.child == nil {
+				x.child = y
+			} else {
+				concat(x.child, y)
+			}
+			x.degree++
+			degrees[d] = nil
+			d++
+		}
+		for d >= len(degrees) {
+			degrees = append(degrees, nil)
+		}
+		degrees[d] = x
+	}
+	h.min = nil
+	for _, n := range degrees {
+		if n != nil && (h.min == nil || h.less(n.value, h.min.value)) {
+			h.min = n
+		}
+	}
+	clear(degrees)
+	clear(h.roots)
+	h.degrees = degrees
+}
+
+func (h *FibonacciHeap[T]) DecreaseKey(n *FibNode[T], e T) bool {
+	if !h.Contains(n) || h.less(n.value, e) {
+		return false
+	}
+	n.value = e
+	if p := n.parent; p != nil && h.less(n.value, p.value) {
+		h.cut(n)
+		// cut marked ancestors, mark the first unmarked one
+		for p.parent != nil {
+			if !p.mark {
+				p.mark = true
+				break
+			}
+			next := p.parent
+			h.cut(p)
+			p = next
+		}
+	}
+	if h.less(n.value, h.min.value) {
+		h.min = n
+	}
+	return true
+}
+
+// cut moves the subtree of n from its parent to the root list.
+func (h *FibonacciHeap[T]) cut(n *FibNode[T]) {
+	p := n.parent
+	if n.right == n {
+		p.child = nil
+	} else {
+		if p.child == n {
+			p.child = n.right
+		}
+		detach(n)
+	}
+	p.degree--
+	n.mark = false
+	h.addRoot(n)
+}
+
+// Meld moves the elements of other, a *FibonacciHeap, in O(1).
+func (h *FibonacciHeap[T]) Meld(other Heap[T, *FibNode[T]]) bool {
+	o, ok := other.(*FibonacciHeap[T])
+	if !ok {
+		return false
+	}
+	if o == h || o.min == nil {
+		return true
+	}
+	h.addRoot(o.min)
+	h.length += o.length
+	o.owner = o.owner.Forward(h.owner)
+	o.min, o.length = nil, 0
+	return true
+}
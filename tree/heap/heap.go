@@ -0,0 +1,289 @@
+// Package heap implements priority queues.
+//
+// The element at the top of a queue is a minimum of the order given by
+// its list.Less, a reversed order turns it into a max-queue.
+//
+// The queues implementing Heap return a handle from Push to decrease the
+// key of the element later and can be melded through the interface:
+// PriorityQueue is a d-ary heap, PairingHeap, FibonacciHeap and LeftistHeap
+// trade constant factors for cheaper Meld and DecreaseKey.
+package heap
+
+import (
+	"go-data-structure/constraints"
+	"go-data-structure/list"
+)
+
+var (
+	_ list.Sequence[int]      = (*PriorityQueue[int])(nil)
+	_ Heap[int, *Handle[int]] = (*PriorityQueue[int])(nil)
+)
+
+// Handle identifies an element of a PriorityQueue for later updates.
+type Handle[T any] struct {
+	value T
+	// position in the heap, -1 once popped or removed
+	index int
+	queue *PriorityQueue[T]
+}
+
+// Value returns the element, the last one set by Update.
+func (h *Handle[T]) Value() T { return h.value }
+
+// PriorityQueue is an implicit d-ary heap stored in a slice, binary by
+// default. Its elements can be updated or removed in O(log n) through the
+// handle returned by Push.
+type PriorityQueue[T any] struct {
+	handles []*Handle[T]
+	less    list.Less[T]
+	arity   int
+}
+
+// New returns a binary heap of the elements built in O(n).
+func New[T any](less list.Less[T], e ...T) *PriorityQueue[T] {
+	return NewDary(2, less, e...)
+}
+
+// NewDary returns a heap whose nodes have up to 'arity' children, built
+// from the elements in O(n). Wider nodes make the heap shallower, favouring
+// Push over Pop.
+func NewDary[T any](arity int, less list.Less[T], e ...T) *PriorityQueue[T] {
+	if arity < 2 {
+		panic("heap: arity should be at least 2")
+	}
+	q := &PriorityQueue[T]{handles: make([]*Handle[T], len(e)), less: less, arity: arity}
+	// a single allocation backs the handles of the initial elements
+	hs := make([]Handle[T], len(e))
+	for i, v := range e {
+		hs[i] = Handle[T]{value: v, index: i, queue: q}
+		q.handles[i] = &hs[i]
+	}
+	q.heapify()
+	return q
+}
+
+// FromSlice returns a heap of the elements of 's' built in O(n), 's' is
+// not retained.
+func FromSlice[T any](arity int, less list.Less[T], s []T) *PriorityQueue[T] {
+	return NewDary(arity, less, s...)
+}
+
+// heapify sifts down every inner node from the last one up.
+func (q *PriorityQueue[T]) heapify() {
+	if len(q.handles) < 2 {
+		return
+	}
+	for i := (len(q.handles) - 2) / q.arity; i >= 0; i-- {
+		q.down(i)
+	}
+}
+
+// NewMinHeap returns a binary heap popping the smallest element first.
+func NewMinHeap[T constraints.Ordered](e ...T) *PriorityQueue[T] {
+	return New(list.Ordered[T]().Less(), e...)
+}
+
+// NewMaxHeap returns a binary heap popping the largest element first.
+func NewMaxHeap[T constraints.Ordered](e ...T) *PriorityQueue[T] {
+	return New(list.Ordered[T]().Reverse().Less(), e...)
+}
+
+func (q *PriorityQueue[T]) Len() int { return len(q.handles) }
+
+func (q *PriorityQueue[T]) Arity() int { return q.arity }
+
+// Clear removes all elements, their handles are no longer contained.
+func (q *PriorityQueue[T]) Clear() {
+	for _, h := range q.handles {
+		h.index, h.queue = -1, nil
+	}
+	clear(q.handles)
+	q.handles = q.handles[:0]
+}
+
+// Contains reports whether the handle's element is in the queue.
+func (q *PriorityQueue[T]) Contains(h *Handle[T]) bool {
+	return h != nil && h.queue == q
+}
+
+// Push adds the element in O(log n) and returns its handle.
+func (q *PriorityQueue[T]) Push(e T) *Handle[T] {
+	h := &Handle[T]{value: e, index: len(q.handles), queue: q}
+	q.handles = append(q.handles, h)
+	q.up(h.index)
+	return h
+}
+
+// Peek returns the top element, false if the queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.handles) == 0 {
+		var e T
+		return e, false
+	}
+	return q.handles[0].value, true
+}
+
+// Pop removes and returns the top element in O(log n), false if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.handles) == 0 {
+		var e T
+		return e, false
+	}
+	h := q.handles[0]
+	q.remove(h)
+	return h.value, true
+}
+
+// PushPop pushes the element then pops the top one in a single sift,
+// returning 'e' itself without touching the heap if it would be on top.
+// The handle of a pushed 'e' is not returned, use Push to keep it.
+func (q *PriorityQueue[T]) PushPop(e T) T {
+	if len(q.handles) == 0 || !q.less(q.handles[0].value, e) {
+		return e
+	}
+	return q.replaceTop(e)
+}
+
+// Replace pops the top element then pushes 'e' in a single sift, the
+// returned element may be larger than 'e'. On an empty queue it only pushes
+// 'e' and returns false. The handle of 'e' is not returned, use Push to
+// keep it.
+func (q *PriorityQueue[T]) Replace(e T) (T, bool) {
+	if len(q.handles) == 0 {
+		q.Push(e)
+		var zero T
+		return zero, false
+	}
+	return q.replaceTop(e), true
+}
+
+// replaceTop swaps the top element for 'e' and returns it.
+func (q *PriorityQueue[T]) replaceTop(e T) T {
+	top := q.handles[0]
+	q.handles[0] = &Handle[T]{value: e, queue: q}
+	top.index, top.queue = -1, nil
+	q.down(0)
+	return top.value
+}
+
+// Update replaces the element of the handle and restores the heap order
+// in O(log n), false if the handle is not in the queue.
+func (q *PriorityQueue[T]) Update(h *Handle[T], e T) bool {
+	if !q.Contains(h) {
+		return false
+	}
+	h.value = e
+	q.fix(h.index)
+	return true
+}
+
+// DecreaseKey replaces the element of the handle with one not greater in
+// O(log n), false if the handle is not in the queue or 'e' is greater.
+func (q *PriorityQueue[T]) DecreaseKey(h *Handle[T], e T) bool {
+	if !q.Contains(h) || q.less(h.value, e) {
+		return false
+	}
+	h.value = e
+	q.up(h.index)
+	return true
+}
+
+// Remove removes the handle's element in O(log n), false if it is not in the queue.
+func (q *PriorityQueue[T]) Remove(h *Handle[T]) bool {
+	if !q.Contains(h) {
+		return false
+	}
+	q.remove(h)
+	return true
+}
+
+// Meld moves the elements of other, a *PriorityQueue of any arity, into
+// the queue and rebuilds the heap in O(n+m).
+func (q *PriorityQueue[T]) Meld(other Heap[T, *Handle[T]]) bool {
+	o, ok := other.(*PriorityQueue[T])
+	if !ok {
+		return false
+	}
+	if o == q {
+		return true
+	}
+	for _, h := range o.handles {
+		h.index, h.queue = len(q.handles), q
+		q.handles = append(q.handles, h)
+	}
+	clear(o.handles)
+	o.handles = o.handles[:0]
+	q.heapify()
+	return true
+}
+
+// Range calls fn for each element in heap order, not sorted, until fn returns false.
+func (q *PriorityQueue[T]) Range(fn func(e T) bool) {
+	for _, h := range q.handles {
+		if !fn(h.value) {
+			return
+		}
+	}
+}
+
+func (q *PriorityQueue[T]) remove(h *Handle[T]) {
+	i, n := h.index, len(q.handles)-1
+	last := q.handles[n]
+	q.handles[n] = nil
+	q.handles = q.handles[:n]
+	if i != n {
+		q.set(i, last)
+		q.fix(i)
+	}
+	h.index, h.queue = -1, nil
+}
+
+// fix moves the element at i up or down to its position.
+func (q *PriorityQueue[T]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+// set places the handle at i.
+func (q *PriorityQueue[T]) set(i int, h *Handle[T]) {
+	q.handles[i], h.index = h, i
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	h := q.handles[i]
+	for i > 0 {
+		parent := (i - 1) / q.arity
+		if !q.less(h.value, q.handles[parent].value) {
+			break
+		}
+		q.set(i, q.handles[parent])
+		i = parent
+	}
+	q.set(i, h)
+}
+
+// down reports whether the element at i moved.
+func (q *PriorityQueue[T]) down(i int) bool {
+	start, h, n := i, q.handles[i], len(q.handles)
+	for {
+		first := q.arity*i + 1
+		if first >= n {
+			break
+		}
+		// the smallest child
+		child := first
+		for c := first + 1; c < min(first+q.arity, n); c++ {
+			if q.less(q.handles[c].value, q.handles[child].value) {
+				child = c
+			}
+		}
+		if !q.less(q.handles[child].value, h.value) {
+			break
+		}
+		q.set(i, q.handles[child])
+		i = child
+	}
+	q.set(i, h)
+	return i > start
+}
@@ -0,0 +1,99 @@
+package heap
+
+import "go-data-structure/list"
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// KeyedQueue is an indexed priority queue addressing elements by a user
+// key instead of a handle, each key is queued at most once.
+type KeyedQueue[K comparable, V any] struct {
+	q       *PriorityQueue[entry[K, V]]
+	handles map[K]*Handle[entry[K, V]]
+}
+
+func NewKeyed[K comparable, V any](less list.Less[V]) *KeyedQueue[K, V] {
+	return &KeyedQueue[K, V]{
+		q: New(func(i, j entry[K, V]) bool {
+			return less(i.value, j.value)
+		}),
+		handles: make(map[K]*Handle[entry[K, V]]),
+	}
+}
+
+func (q *KeyedQueue[K, V]) Len() int { return q.q.Len() }
+
+func (q *KeyedQueue[K, V]) Clear() {
+	q.q.Clear()
+	clear(q.handles)
+}
+
+func (q *KeyedQueue[K, V]) Contains(key K) bool {
+	_, ok := q.handles[key]
+	return ok
+}
+
+// Get returns the element of key, false if the key is not queued.
+func (q *KeyedQueue[K, V]) Get(key K) (V, bool) {
+	if h, ok := q.handles[key]; ok {
+		return h.value.value, true
+	}
+	var v V
+	return v, false
+}
+
+// Put queues the key with the element, or updates its element if already
+// queued, in O(log n).
+func (q *KeyedQueue[K, V]) Put(key K, value V) {
+	e := entry[K, V]{key, value}
+	if h, ok := q.handles[key]; ok {
+		q.q.Update(h, e)
+		return
+	}
+	q.handles[key] = q.q.Push(e)
+}
+
+// Update replaces the element of a queued key in O(log n), false if the
+// key is not queued.
+func (q *KeyedQueue[K, V]) Update(key K, value V) bool {
+	h, ok := q.handles[key]
+	if ok {
+		q.q.Update(h, entry[K, V]{key, value})
+	}
+	return ok
+}
+
+// Remove removes the key in O(log n), false if it is not queued.
+func (q *KeyedQueue[K, V]) Remove(key K) bool {
+	h, ok := q.handles[key]
+	if ok {
+		q.q.Remove(h)
+		delete(q.handles, key)
+	}
+	return ok
+}
+
+// Peek returns the top key and element, false if the queue is empty.
+func (q *KeyedQueue[K, V]) Peek() (K, V, bool) {
+	e, ok := q.q.Peek()
+	return e.key, e.value, ok
+}
+
+// Pop removes and returns the top key and element, false if the queue is empty.
+func (q *KeyedQueue[K, V]) Pop() (K, V, bool) {
+	e, ok := q.q.Pop()
+	if ok {
+		delete(q.handles, e.key)
+	}
+	return e.key, e.value, ok
+}
+
+// Range calls fn for each key and element in heap order, not sorted,
+// until fn returns false. fn must not modify the queue.
+func (q *KeyedQueue[K, V]) Range(fn func(key K, value V) bool) {
+	q.q.Range(func(e entry[K, V]) bool {
+		return fn(e.key, e.value)
+	})
+}
@@ -0,0 +1,191 @@
+package heap
+
+import (
+	"go-data-structure/internal/owner"
+	"go-data-structure/list"
+)
+
+var _ Heap[int, *LeftistNode[int]] = (*LeftistHeap[int])(nil)
+
+// LeftistNode is the handle of an element of a LeftistHeap.
+type LeftistNode[T any] struct {
+	value               T
+	left, right, parent *LeftistNode[T]
+	// length of the shortest path to a missing child, unused by skew heaps
+	rank  int
+	owner *owner.Owner[LeftistHeap[T]]
+}
+
+// Value returns the element.
+func (n *LeftistNode[T]) Value() T { return n.value }
+
+func rank[T any](n *LeftistNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+// LeftistHeap is a binary tree heap melded along right spines, every
+// operation but Peek runs in O(log n).
+//
+// A leftist heap keeps the right spine short by storing ranks, a skew
+// heap swaps children on every meld instead and is O(log n) amortized.
+type LeftistHeap[T any] struct {
+	root   *LeftistNode[T]
+	length int
+	less   list.Less[T]
+	skew   bool
+	owner  *owner.Owner[LeftistHeap[T]]
+	// scratch space of merge
+	path []*LeftistNode[T]
+}
+
+func NewLeftist[T any](less list.Less[T]) *LeftistHeap[T] {
+	h := &LeftistHeap[T]{less: less}
+	h.owner = owner.New(h)
+	return h
+}
+
+func NewSkew[T any](less list.Less[T]) *LeftistHeap[T] {
+	h := &LeftistHeap[T]{less: less, skew: true}
+	h.owner = owner.New(h)
+	return h
+}
+
+func (h *LeftistHeap[T]) Len() int { return h.length }
+
+// Clear removes all elements, their handles are no longer contained.
+func (h *LeftistHeap[T]) Clear() {
+	h.root, h.length, h.owner = nil, 0, h.owner.Release()
+}
+
+func (h *LeftistHeap[T]) Contains(n *LeftistNode[T]) bool {
+	if n == nil {
+		return false
+	}
+	n.owner = owner.Resolve(n.owner)
+	return n.owner.Container() == h
+}
+
+// merge melds two trees along their right spines and returns the root.
+func (h *LeftistHeap[T]) merge(a, b *LeftistNode[T]) *LeftistNode[T] {
+	if a == nil || b == nil {
+		if a == nil {
+			a = b
+		}
+		if a != nil {
+			a.parent = nil
+		}
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	root := a
+	root.parent = nil
+	path := h.path[:0]
+	// a is never greater than b, b goes into the right subtree of a
+	for {
+		path = append(path, a)
+		if a.right == nil {
+			a.right = b
+			b.parent = a
+			break
+		}
+		if h.less(b.value, a.right.value) {
+			a.right, b = b, a.right
+			a.right.parent = a
+		}
+		a = a.right
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		h.restore(path[i])
+	}
+	clear(path)
+	h.path = path
+	return root
+}
+
+// restore swaps the children of n after its right subtree changed, as
+// the rank or skew rule demands, and reports whether the rank changed.
+func (h *LeftistHeap[T]) restore(n *LeftistNode[T]) bool {
+	if h.skew {
+		n.left, n.right = n.right, n.left
+		return true
+	}
+	if rank(n.left) < rank(n.right) {
+		n.left, n.right = n.right, n.left
+	}
+	r := rank(n.right) + 1
+	changed := r != n.rank
+	n.rank = r
+	return changed
+}
+
+func (h *LeftistHeap[T]) Push(e T) *LeftistNode[T] {
+	n := &LeftistNode[T]{value: e, rank: 1, owner: h.owner}
+	h.root = h.merge(h.root, n)
+	h.length++
+	return n
+}
+
+func (h *LeftistHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var e T
+		return e, false
+	}
+	return h.root.value, true
+}
+
+func (h *LeftistHeap[T]) Pop() (T, bool) {
+	r := h.root
+	if r == nil {
+		var e T
+		return e, false
+	}
+	h.root = h.merge(r.left, r.right)
+	h.length--
+	r.left, r.right, r.owner = nil, nil, nil
+	return r.value, true
+}
+
+func (h *LeftistHeap[T]) DecreaseKey(n *LeftistNode[T], e T) bool {
+	if !h.Contains(n) || h.less(n.value, e) {
+		return false
+	}
+	n.value = e
+	p := n.parent
+	if p == nil || !h.less(n.value, p.value) {
+		return true
+	}
+	// cut the subtree of n, repair the ranks above it and meld it back
+	if p.left == n {
+		p.left = nil
+	} else {
+		p.right = nil
+	}
+	n.parent = nil
+	if !h.skew {
+		for ; p != nil && h.restore(p); p = p.parent {
+		}
+	}
+	h.root = h.merge(h.root, n)
+	return true
+}
+
+// Meld moves the elements of other, a *LeftistHeap, in O(log n).
+func (h *LeftistHeap[T]) Meld(other Heap[T, *LeftistNode[T]]) bool {
+	o, ok := other.(*LeftistHeap[T])
+	if !ok {
+		return false
+	}
+	if o == h || o.root == nil {
+		return true
+	}
+	h.root = h.merge(h.root, o.root)
+	h.length += o.length
+	o.owner = o.owner.Forward(h.owner)
+	o.root, o.length = nil, 0
+	return true
+}
@@ -0,0 +1,26 @@
+package heap
+
+import "go-data-structure/list"
+
+// Queue is implemented by every priority queue of the package.
+type Queue[T any] interface {
+	list.Container
+	Peek() (T, bool)
+	Pop() (T, bool)
+}
+
+// Heap is a priority queue whose elements are addressed by the handle of
+// type H returned by Push.
+type Heap[T, H any] interface {
+	Queue[T]
+	Push(e T) H
+	// Contains reports whether the handle's element is in the heap.
+	Contains(h H) bool
+	// DecreaseKey replaces the handle's element with one not greater,
+	// false if the handle is not in the heap or 'e' is greater.
+	DecreaseKey(h H, e T) bool
+	// Meld moves all elements of other into the heap and leaves other
+	// empty, handles of other stay valid. It reports false and moves
+	// nothing if other is not of the same type as the heap.
+	Meld(other Heap[T, H]) bool
+}
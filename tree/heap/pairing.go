@@ -0,0 +1,164 @@
+package heap
+
+import (
+	"go-data-structure/internal/owner"
+	"go-data-structure/list"
+)
+
+var _ Heap[int, *PairingNode[int]] = (*PairingHeap[int])(nil)
+
+// PairingNode is the handle of an element of a PairingHeap.
+type PairingNode[T any] struct {
+	value T
+	// first child and next sibling, prev is the previous sibling or the
+	// parent of a first child
+	child, next, prev *PairingNode[T]
+	owner             *owner.Owner[PairingHeap[T]]
+}
+
+// Value returns the element.
+func (n *PairingNode[T]) Value() T { return n.value }
+
+// PairingHeap is a multiway heap with O(1) Push, Meld and amortized
+// sub-logarithmic DecreaseKey, Pop pairs up the children of the root in
+// O(log n) amortized.
+type PairingHeap[T any] struct {
+	root   *PairingNode[T]
+	length int
+	less   list.Less[T]
+	owner  *owner.Owner[PairingHeap[T]]
+}
+
+func NewPairing[T any](less list.Less[T]) *PairingHeap[T] {
+	h := &PairingHeap[T]{less: less}
+	h.owner = owner.New(h)
+	return h
+}
+
+func (h *PairingHeap[T]) Len() int { return h.length }
+
+// Clear removes all elements, their handles are no longer contained.
+func (h *PairingHeap[T]) Clear() {
+	h.root, h.length, h.owner = nil, 0, h.owner.Release()
+}
+
+func (h *PairingHeap[T]) Contains(n *PairingNode[T]) bool {
+	if n == nil {
+		return false
+	}
+	n.owner = owner.Resolve(n.owner)
+	return n.owner.Container() == h
+}
+
+// link makes the larger of two roots the first child of the other and
+// returns the new root.
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	b.prev = a
+	a.child = b
+	return a
+}
+
+func (h *PairingHeap[T]) Push(e T) *PairingNode[T] {
+	n := &PairingNode[T]{value: e, owner: h.owner}
+	h.root = h.link(h.root, n)
+	h.length++
+	return n
+}
+
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var e T
+		return e, false
+	}
+	return h.root.value, true
+}
+
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	r := h.root
+	if r == nil {
+		var e T
+		return e, false
+	}
+	h.root = h.pair(r.child)
+	h.length--
+	r.child, r.owner = nil, nil
+	return r.value, true
+}
+
+// pair melds the sibling list starting at first: it links pairs from left
+// to right, then folds the pairs into one heap from right to left.
+func (h *PairingHeap[T]) pair(first *PairingNode[T]) *PairingNode[T] {
+	// the linked pairs are chained through next in reverse order
+	var pairs *PairingNode[T]
+	for a := first; a != nil; {
+		b := a.next
+		a.prev, a.next = nil, nil
+		if b == nil {
+			a.next = pairs
+			pairs = a
+			break
+		}
+		rest := b.next
+		b.prev, b.next = nil, nil
+		m := h.link(a, b)
+		m.next = pairs
+		pairs = m
+		a = rest
+	}
+	var root *PairingNode[T]
+	for pairs != nil {
+		n := pairs
+		pairs = n.next
+		n.next = nil
+		root = h.link(root, n)
+	}
+	return root
+}
+
+func (h *PairingHeap[T]) DecreaseKey(n *PairingNode[T], e T) bool {
+	if !h.Contains(n) || h.less(n.value, e) {
+		return false
+	}
+	n.value = e
+	if n == h.root {
+		return true
+	}
+	// cut the subtree of n and link it with the root
+	if n.prev.child == n {
+		n.prev.child = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+	h.root = h.link(h.root, n)
+	return true
+}
+
+// Meld moves the elements of other, a *PairingHeap, in O(1).
+func (h *PairingHeap[T]) Meld(other Heap[T, *PairingNode[T]]) bool {
+	o, ok := other.(*PairingHeap[T])
+	if !ok {
+		return false
+	}
+	if o == h || o.root == nil {
+		return true
+	}
+	h.root = h.link(h.root, o.root)
+	h.length += o.length
+	o.owner = o.owner.Forward(h.owner)
+	o.root, o.length = nil, 0
+	return true
+}
@@ -0,0 +1,190 @@
+// Package maptest implements a behavioral test suite for implementations
+// of list.SortedMap.
+//
+// The suite takes a factory returning a new empty map, drives it through
+// a fixed set of scenarios and a randomized sequence checked against a
+// builtin map, so AvlTree, BTree, SkipMap and third-party maps are all
+// verified against the same expectations:
+//
+//	func TestAvlTree(t *testing.T) {
+//		maptest.TestSortedMap(t, func() list.SortedMap[int, string] { return avltree.New[int, string]() })
+//	}
+package maptest
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"go-data-structure/list"
+)
+
+const _RANDOM_OPS = 5000
+
+// TestSortedMap runs the behavioral suite of list.SortedMap against maps produced by newMap.
+func TestSortedMap(t *testing.T, newMap func() list.SortedMap[int, string]) {
+	t.Run("Empty", func(t *testing.T) {
+		m := newMap()
+		expect(t, m, nil)
+		if _, ok := m.Get(1); ok {
+			t.Errorf("Get(1) on empty map reports present")
+		}
+		if m.Remove(1) {
+			t.Errorf("Remove(1) on empty map reports present")
+		}
+		if _, _, ok := m.Min(); ok {
+			t.Errorf("Min() on empty map reports present")
+		}
+		if _, _, ok := m.Max(); ok {
+			t.Errorf("Max() on empty map reports present")
+		}
+	})
+
+	t.Run("PutGet", func(t *testing.T) {
+		m := newMap()
+		for _, k := range []int{5, 3, 8, 1, 4} {
+			m.Put(k, value(k))
+		}
+		expect(t, m, map[int]string{1: "1", 3: "3", 4: "4", 5: "5", 8: "8"})
+		if _, ok := m.Get(2); ok {
+			t.Errorf("Get(2) reports present")
+		}
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		m := newMap()
+		m.Put(1, "a")
+		m.Put(1, "b")
+		expect(t, m, map[int]string{1: "b"})
+	})
+
+	t.Run("MinMax", func(t *testing.T) {
+		m := newMap()
+		for _, k := range []int{5, -3, 8, 1} {
+			m.Put(k, value(k))
+		}
+		if k, v, ok := m.Min(); !ok || k != -3 || v != "-3" {
+			t.Errorf("Min() = %d, %q, %v, want -3, \"-3\", true", k, v, ok)
+		}
+		if k, v, ok := m.Max(); !ok || k != 8 || v != "8" {
+			t.Errorf("Max() = %d, %q, %v, want 8, \"8\", true", k, v, ok)
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		m := newMap()
+		for k := 0; k < 100; k++ {
+			m.Put(k, value(k))
+		}
+		for k := 0; k < 100; k += 2 {
+			if !m.Remove(k) {
+				t.Fatalf("Remove(%d) reports absent", k)
+			}
+			if m.Remove(k) {
+				t.Fatalf("Remove(%d) twice reports present", k)
+			}
+		}
+		want := map[int]string{}
+		for k := 1; k < 100; k += 2 {
+			want[k] = value(k)
+		}
+		expect(t, m, want)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m := newMap()
+		m.Put(1, "1")
+		m.Put(2, "2")
+		m.Clear()
+		expect(t, m, nil)
+		m.Put(3, "3")
+		expect(t, m, map[int]string{3: "3"})
+	})
+
+	t.Run("RangeStop", func(t *testing.T) {
+		m := newMap()
+		for k := 0; k < 10; k++ {
+			m.Put(k, value(k))
+		}
+		var keys []int
+		m.Range(func(k int, v string) bool {
+			keys = append(keys, k)
+			return k < 2
+		})
+		if !slices.Equal(keys, []int{0, 1, 2}) {
+			t.Errorf("Range stopped at %v, want [0 1 2]", keys)
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		m := newMap()
+		ref := map[int]string{}
+		for i := 0; i < _RANDOM_OPS; i++ {
+			k := r.Intn(300)
+			switch r.Intn(4) {
+			case 0, 1:
+				m.Put(k, value(i))
+				ref[k] = value(i)
+			case 2:
+				_, present := ref[k]
+				if m.Remove(k) != present {
+					t.Fatalf("op %d: Remove(%d) disagrees with model", i, k)
+				}
+				delete(ref, k)
+			case 3:
+				v, ok := m.Get(k)
+				want, present := ref[k]
+				if ok != present || v != want {
+					t.Fatalf("op %d: Get(%d) = %q, %v, want %q, %v", i, k, v, ok, want, present)
+				}
+			}
+			if m.Len() != len(ref) {
+				t.Fatalf("op %d: Len() = %d, want %d", i, m.Len(), len(ref))
+			}
+		}
+		expect(t, m, ref)
+	})
+}
+
+func value(k int) string {
+	return fmt.Sprint(k)
+}
+
+func expect(t *testing.T, m list.SortedMap[int, string], want map[int]string) {
+	t.Helper()
+	if m.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(want))
+	}
+	var keys []int
+	for k := range want {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var got []int
+	m.Range(func(k int, v string) bool {
+		if v != want[k] {
+			t.Errorf("Range visits %d: %q, want %q", k, v, want[k])
+		}
+		got = append(got, k)
+		return true
+	})
+	if !slices.Equal(got, keys) {
+		t.Fatalf("Range visits keys %v, want %v", got, keys)
+	}
+	for _, k := range keys {
+		if v, ok := m.Get(k); !ok || v != want[k] {
+			t.Fatalf("Get(%d) = %q, %v, want %q, true", k, v, ok, want[k])
+		}
+	}
+	if len(keys) > 0 {
+		if k, _, ok := m.Min(); !ok || k != keys[0] {
+			t.Fatalf("Min() = %d, %v, want %d", k, ok, keys[0])
+		}
+		if k, _, ok := m.Max(); !ok || k != keys[len(keys)-1] {
+			t.Fatalf("Max() = %d, %v, want %d", k, ok, keys[len(keys)-1])
+		}
+	}
+}
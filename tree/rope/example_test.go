@@ -0,0 +1,138 @@
+package rope
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func ExampleRope_Concat() {
+	r := FromString("hello ").Concat(FromString("world"))
+	fmt.Println(String(r), r.Len())
+	// Output:
+	// hello world 11
+}
+
+func ExampleRope_Split() {
+	left, right := FromString("hello world").Split(5)
+	fmt.Printf("%q %q\n", String(left), String(right))
+	// Output:
+	// "hello" " world"
+}
+
+func ExampleRope_Insert() {
+	r := FromString("hello world")
+	s := r.Insert(5, ',').Delete(0, 1).Insert(0, 'H')
+	fmt.Println(String(s))
+	fmt.Println(String(r))
+	// Output:
+	// Hello, world
+	// hello world
+}
+
+func ExampleRope_Index() {
+	r := New(1, 2, 3)
+	fmt.Println(r.Index(1))
+	fmt.Println(r.Index(3))
+	// Output:
+	// 2 true
+	// 0 false
+}
+
+func ExampleSubstring() {
+	fmt.Println(Substring(FromString("hello world"), 6, 100))
+	// Output:
+	// world
+}
+
+func ExampleCountLines() {
+	fmt.Println(CountLines(FromString("")))
+	fmt.Println(CountLines(FromString("a\nb")))
+	fmt.Println(CountLines(FromString("a\nb\n")))
+	// Output:
+	// 0
+	// 2
+	// 2
+}
+
+func ExampleCountRunes() {
+	r := FromString("héllo, 世界")
+	fmt.Println(r.Len(), CountRunes(r))
+	// Output:
+	// 14 9
+}
+
+// checks lengths, heights and the AVL invariant of every node
+func check[T any](t *testing.T, n *node[T]) {
+	t.Helper()
+	if n == nil || n.isLeaf() {
+		if n != nil && (n.length != len(n.leaf) || n.h != 1 || n.length == 0) {
+			t.Fatalf("invalid leaf")
+		}
+		return
+	}
+	check(t, n.left)
+	check(t, n.right)
+	if n.length != n.left.size()+n.right.size() {
+		t.Fatalf("length %d, want %d", n.length, n.left.size()+n.right.size())
+	}
+	if n.h != 1+max(n.left.height(), n.right.height()) {
+		t.Fatalf("height %d is stale", n.h)
+	}
+	if d := n.left.height() - n.right.height(); d < -1 || d > 1 {
+		t.Fatalf("unbalanced by %d", d)
+	}
+}
+
+func TestRope(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	r := New[int]()
+	var ref []int
+	for i := 0; i < 1000; i++ {
+		idx := rnd.Intn(len(ref) + 1)
+		switch rnd.Intn(4) {
+		case 0:
+			es := make([]int, rnd.Intn(1200))
+			for j := range es {
+				es[j] = i
+			}
+			r = r.Insert(idx, es...)
+			ref = slices.Insert(ref, idx, es...)
+		case 1:
+			to := idx + rnd.Intn(300)
+			r = r.Delete(idx, to)
+			ref = slices.Delete(ref, idx, min(to, len(ref)))
+		case 2:
+			left, right := r.Split(idx)
+			if !slices.Equal(left.Values(), ref[:idx]) || !slices.Equal(right.Values(), ref[idx:]) {
+				t.Fatalf("op %d: Split(%d) mismatch", i, idx)
+			}
+			r = right.Concat(left)
+			ref = append(slices.Clone(ref[idx:]), ref[:idx]...)
+		case 3:
+			to := idx + rnd.Intn(100)
+			if got := r.Slice(idx, to).Values(); !slices.Equal(got, ref[idx:min(to, len(ref))]) {
+				t.Fatalf("op %d: Slice(%d, %d) mismatch", i, idx, to)
+			}
+		}
+		check(t, r.root)
+		if r.Len() != len(ref) {
+			t.Fatalf("op %d: Len() = %d, want %d", i, r.Len(), len(ref))
+		}
+		if len(ref) > 0 {
+			idx := rnd.Intn(len(ref))
+			if e, ok := r.Index(idx); !ok || e != ref[idx] {
+				t.Fatalf("op %d: Index(%d) = %d, %v, want %d", i, idx, e, ok, ref[idx])
+			}
+		}
+	}
+	if !slices.Equal(r.Values(), ref) {
+		t.Fatalf("Values() mismatch")
+	}
+	b := r.Rebalance()
+	check(t, b.root)
+	if !slices.Equal(b.Values(), ref) || b.Height() > r.Height() {
+		t.Fatalf("Rebalance() = height %d, was %d", b.Height(), r.Height())
+	}
+}
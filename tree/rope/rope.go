@@ -0,0 +1,241 @@
+// Package rope implements a persistent rope, a sequence stored in the
+// leaves of a height balanced binary tree.
+//
+// Concatenation, splitting and indexing take O(log n). Ropes are
+// immutable, every edit returns a new rope sharing the untouched
+// subtrees with the old one.
+package rope
+
+// maximum elements of a leaf
+const _LEAF_MAX = 512
+
+type node[T any] struct {
+	leaf        []T
+	left, right *node[T]
+	length      int
+	h           int
+}
+
+func newLeaf[T any](es []T) *node[T] {
+	if len(es) == 0 {
+		return nil
+	}
+	return &node[T]{leaf: es, length: len(es), h: 1}
+}
+
+func newNode[T any](left, right *node[T]) *node[T] {
+	return &node[T]{
+		left:   left,
+		right:  right,
+		length: left.length + right.length,
+		h:      1 + max(left.height(), right.height()),
+	}
+}
+
+func (n *node[T]) height() int {
+	if n == nil {
+		return 0
+	}
+	return n.h
+}
+
+func (n *node[T]) size() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func (n *node[T]) isLeaf() bool {
+	return n.left == nil
+}
+
+// Rope is an immutable sequence of 'T', the zero value is an empty rope.
+type Rope[T any] struct {
+	root *node[T]
+}
+
+// New returns a balanced rope holding a copy of the elements.
+func New[T any](e ...T) *Rope[T] {
+	return &Rope[T]{root: build(append([]T(nil), e...))}
+}
+
+// build returns a balanced tree over the elements without copying them.
+func build[T any](es []T) *node[T] {
+	if len(es) <= _LEAF_MAX {
+		return newLeaf(es)
+	}
+	// split at a leaf boundary so leaves stay full
+	leaves := (len(es) + _LEAF_MAX - 1) / _LEAF_MAX
+	mid := leaves / 2 * _LEAF_MAX
+	return newNode(build(es[:mid]), build(es[mid:]))
+}
+
+func (r *Rope[T]) Len() int {
+	return r.root.size()
+}
+
+func (r *Rope[T]) Height() int {
+	return r.root.height()
+}
+
+// Index returns the element at idx, false if out of range.
+func (r *Rope[T]) Index(idx int) (T, bool) {
+	var e T
+	if idx < 0 || idx >= r.Len() {
+		return e, false
+	}
+	n := r.root
+	for !n.isLeaf() {
+		if idx < n.left.length {
+			n = n.left
+		} else {
+			idx -= n.left.length
+			n = n.right
+		}
+	}
+	return n.leaf[idx], true
+}
+
+// Concat returns the rope of the elements of 'r' followed by the elements of 'other'.
+func (r *Rope[T]) Concat(other *Rope[T]) *Rope[T] {
+	return &Rope[T]{root: join(r.root, other.root)}
+}
+
+// Split returns the ropes of elements before idx and from idx on, idx is clamped.
+func (r *Rope[T]) Split(idx int) (*Rope[T], *Rope[T]) {
+	left, right := split(r.root, min(max(idx, 0), r.Len()))
+	return &Rope[T]{root: left}, &Rope[T]{root: right}
+}
+
+// Insert returns the rope with elements inserted before idx, idx is clamped.
+func (r *Rope[T]) Insert(idx int, e ...T) *Rope[T] {
+	left, right := split(r.root, min(max(idx, 0), r.Len()))
+	return &Rope[T]{root: join(join(left, build(append([]T(nil), e...))), right)}
+}
+
+// Delete returns the rope without elements in range [from, to), the range is clamped.
+func (r *Rope[T]) Delete(from, to int) *Rope[T] {
+	from, to = r.clamp(from, to)
+	left, rest := split(r.root, from)
+	_, right := split(rest, to-from)
+	return &Rope[T]{root: join(left, right)}
+}
+
+// Slice returns the rope of elements in range [from, to), the range is clamped.
+func (r *Rope[T]) Slice(from, to int) *Rope[T] {
+	from, to = r.clamp(from, to)
+	_, rest := split(r.root, from)
+	middle, _ := split(rest, to-from)
+	return &Rope[T]{root: middle}
+}
+
+func (r *Rope[T]) clamp(from, to int) (int, int) {
+	from, to = max(from, 0), min(to, r.Len())
+	return from, max(from, to)
+}
+
+// Range calls fn for each element in order until fn returns false.
+func (r *Rope[T]) Range(fn func(e T) bool) {
+	r.root.walk(func(leaf []T) bool {
+		for _, e := range leaf {
+			if !fn(e) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Values returns a copy of the elements.
+func (r *Rope[T]) Values() []T {
+	es := make([]T, 0, r.Len())
+	r.root.walk(func(leaf []T) bool {
+		es = append(es, leaf...)
+		return true
+	})
+	return es
+}
+
+// Rebalance returns an equivalent rope of minimal height with full leaves,
+// useful after many small edits fragmented the leaves.
+func (r *Rope[T]) Rebalance() *Rope[T] {
+	return &Rope[T]{root: build(r.Values())}
+}
+
+// in-order traversal of leaves, returns false once fn stops it
+func (n *node[T]) walk(fn func(leaf []T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.isLeaf() {
+		return fn(n.leaf)
+	}
+	return n.left.walk(fn) && n.right.walk(fn)
+}
+
+// join concatenates two balanced trees into a balanced tree, descending
+// the spine of the higher one like the AVL join algorithm.
+func join[T any](left, right *node[T]) *node[T] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.h > right.h+1 {
+		return balance(left.left, join(left.right, right))
+	}
+	if right.h > left.h+1 {
+		return balance(join(left, right.left), right.right)
+	}
+	if left.isLeaf() && right.isLeaf() && left.length+right.length <= _LEAF_MAX {
+		es := make([]T, 0, left.length+right.length)
+		return newLeaf(append(append(es, left.leaf...), right.leaf...))
+	}
+	return newNode(left, right)
+}
+
+// balance returns a tree of 'left' followed by 'right', whose heights
+// differ by at most 2, restoring the AVL invariant by rotation.
+func balance[T any](left, right *node[T]) *node[T] {
+	switch unbalance := left.height() - right.height(); {
+	case unbalance > 1:
+		// LR: rotate the left child left first
+		if left.right.height() > left.left.height() {
+			left = newNode(newNode(left.left, left.right.left), left.right.right)
+		}
+		// LL: rotate right
+		return newNode(left.left, newNode(left.right, right))
+	case unbalance < -1:
+		// RL: rotate the right child right first
+		if right.left.height() > right.right.height() {
+			right = newNode(right.left.left, newNode(right.left.right, right.right))
+		}
+		// RR: rotate left
+		return newNode(newNode(left, right.left), right.right)
+	}
+	return newNode(left, right)
+}
+
+// split divides a tree into trees of the first idx elements and the rest.
+func split[T any](n *node[T], idx int) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if idx <= 0 {
+		return nil, n
+	}
+	if idx >= n.length {
+		return n, nil
+	}
+	if n.isLeaf() {
+		return newLeaf(n.leaf[:idx:idx]), newLeaf(n.leaf[idx:])
+	}
+	if idx < n.left.length {
+		left, right := split(n.left, idx)
+		return left, join(right, n.right)
+	}
+	left, right := split(n.right, idx-n.left.length)
+	return join(n.left, left), right
+}
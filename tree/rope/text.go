@@ -0,0 +1,52 @@
+package rope
+
+import "unicode/utf8"
+
+// FromString returns a rope of the bytes of 's'.
+func FromString(s string) *Rope[byte] {
+	return &Rope[byte]{root: build([]byte(s))}
+}
+
+// String returns the bytes of the rope as a string.
+func String(r *Rope[byte]) string {
+	return string(r.Values())
+}
+
+// Substring returns bytes in range [from, to) as a string, the range is clamped.
+func Substring(r *Rope[byte], from, to int) string {
+	return String(r.Slice(from, to))
+}
+
+// CountLines returns the number of lines, a last line without newline counts.
+func CountLines(r *Rope[byte]) int {
+	lines, last := 0, byte('\n')
+	r.root.walk(func(leaf []byte) bool {
+		for _, b := range leaf {
+			if b == '\n' {
+				lines++
+			}
+		}
+		last = leaf[len(leaf)-1]
+		return true
+	})
+	if last != '\n' {
+		lines++
+	}
+	return lines
+}
+
+// CountRunes returns the number of runes of valid UTF-8 text, runes may span leaves.
+func CountRunes(r *Rope[byte]) int {
+	runes := 0
+	r.root.walk(func(leaf []byte) bool {
+		for _, b := range leaf {
+			// every rune has exactly one byte which is not a continuation byte
+			if !utf8.RuneStart(b) {
+				continue
+			}
+			runes++
+		}
+		return true
+	})
+	return runes
+}